@@ -457,6 +457,15 @@ func (app *Application) runServer() {
 
 	clientAPIKeyStore := models.NewClientAPIKeyStore(db.Conn())
 	errorStore := models.NewInstanceErrorStore(db.Conn())
+	searchSettingsStore := models.NewSearchSettingsStore(db.Conn())
+	duplicateSettingsStore := models.NewDuplicateSettingsStore(db.Conn())
+	userPreferencesStore := models.NewUserPreferencesStore(db.Conn())
+	refreshSettingsStore := models.NewRefreshSettingsStore(db.Conn())
+	scheduledActionStore := models.NewScheduledActionStore(db.Conn())
+	torrentEventStore := models.NewTorrentEventStore(db.Conn())
+	diskSpaceSettingsStore := models.NewDiskSpaceSettingsStore(db.Conn())
+	completedCategoryStore := models.NewCompletedCategorySettingsStore(db.Conn())
+	sidebarMetadataStore := models.NewSidebarMetadataStore(db.Conn())
 
 	// Initialize services
 	authService := auth.NewService(db.Conn())
@@ -467,6 +476,14 @@ func (app *Application) runServer() {
 		checker.StartPeriodicChecks(context.Background())
 	}()
 
+	licenseScheduler := license.NewRefreshScheduler(licenseService, time.Duration(cfg.Config.LicenseRefreshInterval)*time.Hour)
+	cfg.RegisterReloadListener(func(conf *domain.Config) {
+		licenseScheduler.SetInterval(time.Duration(conf.LicenseRefreshInterval) * time.Hour)
+	})
+	licenseCtx, cancelLicense := context.WithCancel(context.Background())
+	defer cancelLicense()
+	go licenseScheduler.Start(licenseCtx)
+
 	// Initialize qBittorrent client pool
 	clientPool, err := qbittorrent.NewClientPool(instanceStore, errorStore)
 	if err != nil {
@@ -476,6 +493,62 @@ func (app *Application) runServer() {
 
 	// Initialize managers
 	syncManager := qbittorrent.NewSyncManager(clientPool)
+	syncManager.SetOptimisticUpdateTimeout(time.Duration(cfg.Config.OptimisticUpdateTimeout) * time.Second)
+	syncManager.SetReannounceMinInterval(time.Duration(cfg.Config.ReannounceMinInterval) * time.Second)
+	if err := syncManager.SetTimeZone(cfg.Config.Timezone); err != nil {
+		log.Warn().Err(err).Msg("Invalid timezone, using the server's local time zone")
+	}
+	syncManager.SetPageSizeLimits(cfg.Config.TorrentsPageSize, cfg.Config.TorrentsMaxPageSize)
+	syncManager.SetActiveWindow(time.Duration(cfg.Config.ActiveWindowMinutes) * time.Minute)
+	cfg.RegisterReloadListener(func(conf *domain.Config) {
+		syncManager.SetOptimisticUpdateTimeout(time.Duration(conf.OptimisticUpdateTimeout) * time.Second)
+		syncManager.SetReannounceMinInterval(time.Duration(conf.ReannounceMinInterval) * time.Second)
+		if err := syncManager.SetTimeZone(conf.Timezone); err != nil {
+			log.Warn().Err(err).Msg("Invalid timezone, keeping the previous time zone")
+		}
+		syncManager.SetPageSizeLimits(conf.TorrentsPageSize, conf.TorrentsMaxPageSize)
+		syncManager.SetActiveWindow(time.Duration(conf.ActiveWindowMinutes) * time.Minute)
+	})
+	syncManager.SetSidebarMetadataStore(sidebarMetadataStore)
+	if weights, err := searchSettingsStore.GetWeights(context.Background()); err != nil {
+		log.Warn().Err(err).Msg("Failed to load search weights, using defaults")
+	} else {
+		syncManager.SetSearchWeights(weights)
+	}
+	if duplicateSettings, err := duplicateSettingsStore.List(context.Background()); err != nil {
+		log.Warn().Err(err).Msg("Failed to load duplicate settings, using defaults")
+	} else {
+		for instanceID, settings := range duplicateSettings {
+			if err := syncManager.SetDuplicateStripPatterns(instanceID, settings.StripPatterns); err != nil {
+				log.Warn().Err(err).Int("instanceID", instanceID).Msg("Ignoring invalid duplicate strip patterns")
+			}
+		}
+	}
+
+	actionScheduler := qbittorrent.NewActionScheduler(scheduledActionStore, syncManager)
+	schedulerCtx, cancelScheduler := context.WithCancel(context.Background())
+	defer cancelScheduler()
+	go actionScheduler.Start(schedulerCtx)
+
+	timelineCollector := qbittorrent.NewTimelineCollector(torrentEventStore, syncManager)
+	completedCategoryMover := qbittorrent.NewCompletedCategoryMover(completedCategoryStore, syncManager)
+	timelineCollector.OnEvents(completedCategoryMover.HandleEvents)
+	timelineCtx, cancelTimeline := context.WithCancel(context.Background())
+	defer cancelTimeline()
+	go timelineCollector.Start(timelineCtx)
+
+	diskSpaceGuard := qbittorrent.NewDiskSpaceGuard(diskSpaceSettingsStore, syncManager)
+	diskSpaceCtx, cancelDiskSpace := context.WithCancel(context.Background())
+	defer cancelDiskSpace()
+	go diskSpaceGuard.Start(diskSpaceCtx)
+
+	backupScheduler := qbittorrent.NewTorrentBackupScheduler(syncManager, cfg.GetBackupDir(), time.Duration(cfg.Config.BackupInterval)*time.Hour, cfg.Config.BackupRetention)
+	cfg.RegisterReloadListener(func(conf *domain.Config) {
+		backupScheduler.SetConfig(cfg.GetBackupDir(), time.Duration(conf.BackupInterval)*time.Hour, conf.BackupRetention)
+	})
+	backupCtx, cancelBackup := context.WithCancel(context.Background())
+	defer cancelBackup()
+	go backupScheduler.Start(backupCtx)
 
 	updateService := update.NewService(log.Logger, cfg.Config.CheckForUpdates, buildinfo.Version, buildinfo.UserAgent)
 	cfg.RegisterReloadListener(func(conf *domain.Config) {
@@ -527,16 +600,27 @@ func (app *Application) runServer() {
 
 	// Start server in goroutine
 	httpServer := api.NewServer(&api.Dependencies{
-		Config:            cfg,
-		Version:           buildinfo.Version,
-		AuthService:       authService,
-		SessionManager:    sessionManager,
-		InstanceStore:     instanceStore,
-		ClientAPIKeyStore: clientAPIKeyStore,
-		ClientPool:        clientPool,
-		SyncManager:       syncManager,
-		LicenseService:    licenseService,
-		UpdateService:     updateService,
+		Config:                 cfg,
+		Version:                buildinfo.Version,
+		AuthService:            authService,
+		SessionManager:         sessionManager,
+		InstanceStore:          instanceStore,
+		ClientAPIKeyStore:      clientAPIKeyStore,
+		SearchSettingsStore:    searchSettingsStore,
+		DuplicateSettingsStore: duplicateSettingsStore,
+		UserPreferencesStore:   userPreferencesStore,
+		RefreshSettingsStore:   refreshSettingsStore,
+		ClientPool:             clientPool,
+		SyncManager:            syncManager,
+		LicenseService:         licenseService,
+		LicenseScheduler:       licenseScheduler,
+		ActionScheduler:        actionScheduler,
+		DiskSpaceSettingsStore: diskSpaceSettingsStore,
+		DiskSpaceGuard:         diskSpaceGuard,
+		CompletedCategoryStore: completedCategoryStore,
+		TimelineCollector:      timelineCollector,
+		BackupScheduler:        backupScheduler,
+		UpdateService:          updateService,
 	})
 
 	errorChannel := make(chan error)
@@ -165,6 +165,7 @@ func (h *AuthHandler) warmSession(ctx context.Context) {
 			0,
 			"added_on",
 			"desc",
+			false,
 			"",
 			qbittorrent.FilterOptions{},
 		)
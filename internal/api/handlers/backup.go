@@ -0,0 +1,43 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog/log"
+
+	"github.com/autobrr/qui/internal/qbittorrent"
+)
+
+// BackupHandler triggers .torrent backups of instances
+type BackupHandler struct {
+	scheduler *qbittorrent.TorrentBackupScheduler
+}
+
+func NewBackupHandler(scheduler *qbittorrent.TorrentBackupScheduler) *BackupHandler {
+	return &BackupHandler{
+		scheduler: scheduler,
+	}
+}
+
+// BackupNow writes a .torrent backup of the instance immediately
+func (h *BackupHandler) BackupNow(w http.ResponseWriter, r *http.Request) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return
+	}
+
+	backup, err := h.scheduler.BackupInstance(r.Context(), instanceID)
+	if err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to back up torrents")
+		RespondError(w, http.StatusInternalServerError, "Failed to back up torrents")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, backup)
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog/log"
+
+	"github.com/autobrr/qui/internal/models"
+)
+
+// CompletedCategoryHandler manages the category mappings completed torrents are moved by
+type CompletedCategoryHandler struct {
+	store *models.CompletedCategorySettingsStore
+}
+
+func NewCompletedCategoryHandler(store *models.CompletedCategorySettingsStore) *CompletedCategoryHandler {
+	return &CompletedCategoryHandler{
+		store: store,
+	}
+}
+
+// GetCompletedCategorySettings returns the completed category settings of an instance
+func (h *CompletedCategoryHandler) GetCompletedCategorySettings(w http.ResponseWriter, r *http.Request) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return
+	}
+
+	settings, err := h.store.Get(r.Context(), instanceID)
+	if err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to get completed category settings")
+		RespondError(w, http.StatusInternalServerError, "Failed to get completed category settings")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, settings)
+}
+
+// UpdateCompletedCategorySettings stores the completed category settings of an instance
+func (h *CompletedCategoryHandler) UpdateCompletedCategorySettings(w http.ResponseWriter, r *http.Request) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return
+	}
+
+	var settings models.CompletedCategorySettings
+	if err := json.NewDecoder(r.Body).Decode(&settings); err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if settings.Mappings == nil {
+		settings.Mappings = map[string]string{}
+	}
+
+	if err := h.store.Update(r.Context(), instanceID, settings); err != nil {
+		if errors.Is(err, models.ErrInvalidCompletedCategorySettings) {
+			RespondError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to update completed category settings")
+		RespondError(w, http.StatusInternalServerError, "Failed to update completed category settings")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, settings)
+}
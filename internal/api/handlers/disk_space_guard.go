@@ -0,0 +1,111 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog/log"
+
+	"github.com/autobrr/qui/internal/models"
+	"github.com/autobrr/qui/internal/qbittorrent"
+)
+
+// DiskSpaceGuardHandler manages the low disk space guard of instances
+type DiskSpaceGuardHandler struct {
+	store *models.DiskSpaceSettingsStore
+	guard *qbittorrent.DiskSpaceGuard
+}
+
+func NewDiskSpaceGuardHandler(store *models.DiskSpaceSettingsStore, guard *qbittorrent.DiskSpaceGuard) *DiskSpaceGuardHandler {
+	return &DiskSpaceGuardHandler{
+		store: store,
+		guard: guard,
+	}
+}
+
+// DiskSpaceGuardResponse is the configuration and current state of an instance's guard
+type DiskSpaceGuardResponse struct {
+	models.DiskSpaceSettings
+	Status qbittorrent.DiskSpaceStatus `json:"status"`
+}
+
+// GetDiskSpaceGuard returns the low disk space guard settings and status of an instance
+func (h *DiskSpaceGuardHandler) GetDiskSpaceGuard(w http.ResponseWriter, r *http.Request) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return
+	}
+
+	settings, err := h.store.Get(r.Context(), instanceID)
+	if err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to get disk space settings")
+		RespondError(w, http.StatusInternalServerError, "Failed to get disk space settings")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, DiskSpaceGuardResponse{
+		DiskSpaceSettings: settings,
+		Status:            h.guard.GetStatus(instanceID),
+	})
+}
+
+// UpdateDiskSpaceGuard stores the low disk space guard settings of an instance
+func (h *DiskSpaceGuardHandler) UpdateDiskSpaceGuard(w http.ResponseWriter, r *http.Request) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return
+	}
+
+	var settings models.DiskSpaceSettings
+	if err := json.NewDecoder(r.Body).Decode(&settings); err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if err := h.store.Update(r.Context(), instanceID, settings); err != nil {
+		if errors.Is(err, models.ErrInvalidDiskSpaceSettings) {
+			RespondError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to update disk space settings")
+		RespondError(w, http.StatusInternalServerError, "Failed to update disk space settings")
+		return
+	}
+
+	if !settings.Enabled {
+		h.guard.Forget(instanceID)
+	}
+
+	RespondJSON(w, http.StatusOK, DiskSpaceGuardResponse{
+		DiskSpaceSettings: settings,
+		Status:            h.guard.GetStatus(instanceID),
+	})
+}
+
+// ResumeDiskSpaceGuard resumes the torrents the guard paused on an instance
+func (h *DiskSpaceGuardHandler) ResumeDiskSpaceGuard(w http.ResponseWriter, r *http.Request) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return
+	}
+
+	resumed, err := h.guard.Resume(r.Context(), instanceID)
+	if err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to resume torrents paused for low disk space")
+		RespondError(w, http.StatusInternalServerError, "Failed to resume torrents")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, map[string]int{
+		"resumed": resumed,
+	})
+}
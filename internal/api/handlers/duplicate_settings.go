@@ -0,0 +1,122 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog/log"
+
+	"github.com/autobrr/qui/internal/models"
+	"github.com/autobrr/qui/internal/qbittorrent"
+)
+
+// DuplicateSettingsHandler manages how torrent names are normalized for duplicate detection
+type DuplicateSettingsHandler struct {
+	store       *models.DuplicateSettingsStore
+	syncManager *qbittorrent.SyncManager
+}
+
+func NewDuplicateSettingsHandler(store *models.DuplicateSettingsStore, syncManager *qbittorrent.SyncManager) *DuplicateSettingsHandler {
+	return &DuplicateSettingsHandler{
+		store:       store,
+		syncManager: syncManager,
+	}
+}
+
+// PreviewNormalizedNameRequest is a name to normalize, optionally with patterns to try instead
+// of the saved ones
+type PreviewNormalizedNameRequest struct {
+	Name          string   `json:"name"`
+	StripPatterns []string `json:"stripPatterns,omitempty"`
+}
+
+// GetDuplicateSettings returns the duplicate detection settings of an instance
+func (h *DuplicateSettingsHandler) GetDuplicateSettings(w http.ResponseWriter, r *http.Request) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return
+	}
+
+	settings, err := h.store.Get(r.Context(), instanceID)
+	if err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to get duplicate settings")
+		RespondError(w, http.StatusInternalServerError, "Failed to get duplicate settings")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, settings)
+}
+
+// UpdateDuplicateSettings stores new duplicate detection settings and applies them to subsequent lookups
+func (h *DuplicateSettingsHandler) UpdateDuplicateSettings(w http.ResponseWriter, r *http.Request) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return
+	}
+
+	var settings models.DuplicateSettings
+	if err := json.NewDecoder(r.Body).Decode(&settings); err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if settings.StripPatterns == nil {
+		settings.StripPatterns = []string{}
+	}
+
+	if err := h.store.Update(r.Context(), instanceID, settings); err != nil {
+		if errors.Is(err, models.ErrInvalidStripPattern) {
+			RespondError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to update duplicate settings")
+		RespondError(w, http.StatusInternalServerError, "Failed to update duplicate settings")
+		return
+	}
+
+	if err := h.syncManager.SetDuplicateStripPatterns(instanceID, settings.StripPatterns); err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to apply duplicate settings")
+		RespondError(w, http.StatusInternalServerError, "Failed to apply duplicate settings")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, settings)
+}
+
+// PreviewNormalizedName shows the form of a name that duplicate detection compares
+func (h *DuplicateSettingsHandler) PreviewNormalizedName(w http.ResponseWriter, r *http.Request) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return
+	}
+
+	var req PreviewNormalizedNameRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if req.Name == "" {
+		RespondError(w, http.StatusBadRequest, "Name is required")
+		return
+	}
+
+	normalized, err := h.syncManager.PreviewContentName(instanceID, req.Name, req.StripPatterns)
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, map[string]string{
+		"name":       req.Name,
+		"normalized": normalized,
+	})
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog/log"
+
+	"github.com/autobrr/qui/internal/qbittorrent"
+)
+
+// FilterActionRequest selects torrents by the filters and search of the torrent table
+type FilterActionRequest struct {
+	Filters       qbittorrent.FilterOptions `json:"filters"`
+	Search        string                    `json:"search,omitempty"`
+	ExcludeHashes []string                  `json:"excludeHashes,omitempty"`
+	Tags          []string                  `json:"tags,omitempty"`     // For tag operations
+	Category      string                    `json:"category,omitempty"` // For setCategory; empty removes the category
+}
+
+// AddTagsByFilter adds tags to every torrent matching the filters and search
+func (h *TorrentsHandler) AddTagsByFilter(w http.ResponseWriter, r *http.Request) {
+	h.filterAction(w, r, "addTags")
+}
+
+// RemoveTagsByFilter removes tags from every torrent matching the filters and search
+func (h *TorrentsHandler) RemoveTagsByFilter(w http.ResponseWriter, r *http.Request) {
+	h.filterAction(w, r, "removeTags")
+}
+
+// SetCategoryByFilter sets the category of every torrent matching the filters and search
+func (h *TorrentsHandler) SetCategoryByFilter(w http.ResponseWriter, r *http.Request) {
+	h.filterAction(w, r, "setCategory")
+}
+
+func (h *TorrentsHandler) filterAction(w http.ResponseWriter, r *http.Request, action string) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return
+	}
+
+	var req FilterActionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	var affected int
+	switch action {
+	case "addTags", "removeTags":
+		if len(req.Tags) == 0 {
+			RespondError(w, http.StatusBadRequest, "At least one tag is required")
+			return
+		}
+		if action == "addTags" {
+			affected, err = h.syncManager.AddTagsByFilter(r.Context(), instanceID, req.Search, req.Filters, req.ExcludeHashes, req.Tags)
+		} else {
+			affected, err = h.syncManager.RemoveTagsByFilter(r.Context(), instanceID, req.Search, req.Filters, req.ExcludeHashes, req.Tags)
+		}
+	case "setCategory":
+		affected, err = h.syncManager.SetCategoryByFilter(r.Context(), instanceID, req.Search, req.Filters, req.ExcludeHashes, req.Category)
+	}
+
+	if errors.Is(err, qbittorrent.ErrInvalidTag) {
+		RespondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Str("action", action).Msg("Failed to apply action to filtered torrents")
+		RespondError(w, http.StatusInternalServerError, "Failed to update torrents")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, map[string]int{
+		"affected": affected,
+	})
+}
@@ -66,6 +66,10 @@ func (h *InstancesHandler) buildInstanceResponsesParallel(ctx context.Context, i
 				Username:           instances[i].Username,
 				BasicUsername:      instances[i].BasicUsername,
 				TLSSkipVerify:      instances[i].TLSSkipVerify,
+				AddDefaults:        instances[i].AddDefaults,
+				RateLimit:          instances[i].RateLimit,
+				DisableFuzzySearch: instances[i].DisableFuzzySearch,
+				Proxy:              proxyResponse(instances[i]),
 				Connected:          false,
 				HasDecryptionError: false,
 			}
@@ -91,6 +95,10 @@ func (h *InstancesHandler) buildInstanceResponse(ctx context.Context, instance *
 		Username:           instance.Username,
 		BasicUsername:      instance.BasicUsername,
 		TLSSkipVerify:      instance.TLSSkipVerify,
+		AddDefaults:        instance.AddDefaults,
+		RateLimit:          instance.RateLimit,
+		DisableFuzzySearch: instance.DisableFuzzySearch,
+		Proxy:              proxyResponse(instance),
 		Connected:          healthy,
 		HasDecryptionError: hasDecryptionError,
 	}
@@ -103,6 +111,9 @@ func (h *InstancesHandler) buildInstanceResponse(ctx context.Context, instance *
 			log.Error().Err(err).Int("instanceID", instance.ID).Msg("Failed to get recent errors")
 		} else {
 			response.RecentErrors = recentErrors
+			if len(recentErrors) > 0 {
+				response.LastError = &recentErrors[0]
+			}
 		}
 	}
 
@@ -118,11 +129,24 @@ func (h *InstancesHandler) buildQuickInstanceResponse(instance *models.Instance)
 		Username:           instance.Username,
 		BasicUsername:      instance.BasicUsername,
 		TLSSkipVerify:      instance.TLSSkipVerify,
+		AddDefaults:        instance.AddDefaults,
+		RateLimit:          instance.RateLimit,
+		DisableFuzzySearch: instance.DisableFuzzySearch,
+		Proxy:              proxyResponse(instance),
 		Connected:          false, // Will be updated asynchronously
 		HasDecryptionError: false,
 	}
 }
 
+// proxyResponse returns the instance's proxy with the password redacted, or nil without a proxy
+func proxyResponse(instance *models.Instance) *models.InstanceProxy {
+	if !instance.Proxy.Enabled() {
+		return nil
+	}
+	proxy := instance.RedactedProxy()
+	return &proxy
+}
+
 // testConnectionAsync tests connection in background and updates cache
 func (h *InstancesHandler) testConnectionAsync(instanceID int) {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
@@ -153,6 +177,10 @@ type CreateInstanceRequest struct {
 	BasicUsername *string `json:"basicUsername,omitempty"`
 	BasicPassword *string `json:"basicPassword,omitempty"`
 	TLSSkipVerify bool    `json:"tlsSkipVerify,omitempty"`
+	// Proxy routes requests to the instance through an HTTP or SOCKS5 proxy when provided
+	Proxy *models.InstanceProxy `json:"proxy,omitempty"`
+	// AllowDuplicate permits a second instance with the same host and username
+	AllowDuplicate bool `json:"allowDuplicate,omitempty"`
 }
 
 // UpdateInstanceRequest represents a request to update an instance
@@ -164,19 +192,35 @@ type UpdateInstanceRequest struct {
 	BasicUsername *string `json:"basicUsername,omitempty"`
 	BasicPassword *string `json:"basicPassword,omitempty"`
 	TLSSkipVerify *bool   `json:"tlsSkipVerify,omitempty"`
+	// AddDefaults replaces the instance's add defaults when provided
+	AddDefaults *models.InstanceAddDefaults `json:"addDefaults,omitempty"`
+	// RateLimit sets the outbound requests per second to the instance when provided (0 = unlimited)
+	RateLimit *float64 `json:"rateLimit,omitempty"`
+	// DisableFuzzySearch restricts torrent search to literal matches when provided
+	DisableFuzzySearch *bool `json:"disableFuzzySearch,omitempty"`
+	// Proxy replaces the instance's proxy when provided; an empty type removes it and a
+	// redacted password keeps the stored one
+	Proxy *models.InstanceProxy `json:"proxy,omitempty"`
+	// AllowDuplicate permits the same host and username as another instance
+	AllowDuplicate bool `json:"allowDuplicate,omitempty"`
 }
 
 // InstanceResponse represents an instance in API responses
 type InstanceResponse struct {
-	ID                 int                    `json:"id"`
-	Name               string                 `json:"name"`
-	Host               string                 `json:"host"`
-	Username           string                 `json:"username"`
-	BasicUsername      *string                `json:"basicUsername,omitempty"`
-	TLSSkipVerify      bool                   `json:"tlsSkipVerify"`
-	Connected          bool                   `json:"connected"`
-	HasDecryptionError bool                   `json:"hasDecryptionError"`
-	RecentErrors       []models.InstanceError `json:"recentErrors,omitempty"`
+	ID                 int                        `json:"id"`
+	Name               string                     `json:"name"`
+	Host               string                     `json:"host"`
+	Username           string                     `json:"username"`
+	BasicUsername      *string                    `json:"basicUsername,omitempty"`
+	TLSSkipVerify      bool                       `json:"tlsSkipVerify"`
+	AddDefaults        models.InstanceAddDefaults `json:"addDefaults"`
+	RateLimit          float64                    `json:"rateLimit"`
+	DisableFuzzySearch bool                       `json:"disableFuzzySearch"`
+	Proxy              *models.InstanceProxy      `json:"proxy,omitempty"`
+	Connected          bool                       `json:"connected"`
+	HasDecryptionError bool                       `json:"hasDecryptionError"`
+	RecentErrors       []models.InstanceError     `json:"recentErrors,omitempty"`
+	LastError          *models.InstanceError      `json:"lastError,omitempty"` // Most recent error, categorized with guidance
 }
 
 // TestConnectionResponse represents connection test results
@@ -219,14 +263,39 @@ func (h *InstancesHandler) CreateInstance(w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	if req.Proxy != nil {
+		if err := req.Proxy.Validate(); err != nil {
+			RespondError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
 	// Create instance
-	instance, err := h.instanceStore.Create(r.Context(), req.Name, req.Host, req.Username, req.Password, req.BasicUsername, req.BasicPassword, req.TLSSkipVerify)
+	instance, err := h.instanceStore.Create(r.Context(), req.Name, req.Host, req.Username, req.Password, req.BasicUsername, req.BasicPassword, req.TLSSkipVerify, req.AllowDuplicate)
 	if err != nil {
+		if errors.Is(err, models.ErrDuplicateInstance) {
+			RespondError(w, http.StatusConflict, err.Error())
+			return
+		}
+		if errors.Is(err, models.ErrInvalidHost) {
+			RespondError(w, http.StatusBadRequest, err.Error())
+			return
+		}
 		log.Error().Err(err).Msg("Failed to create instance")
 		RespondError(w, http.StatusInternalServerError, "Failed to create instance")
 		return
 	}
 
+	// The proxy is applied in a second step, before the first connection attempt
+	if req.Proxy != nil && req.Proxy.Enabled() {
+		instance, err = h.instanceStore.Update(r.Context(), instance.ID, instance.Name, instance.Host, instance.Username, "", instance.BasicUsername, nil, nil, nil, nil, nil, req.Proxy, true)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to apply instance proxy")
+			RespondError(w, http.StatusInternalServerError, "Failed to apply instance proxy")
+			return
+		}
+	}
+
 	// Return quickly without testing connection
 	response := h.buildQuickInstanceResponse(instance)
 
@@ -280,12 +349,20 @@ func (h *InstancesHandler) UpdateInstance(w http.ResponseWriter, r *http.Request
 	}
 
 	// Update instance
-	instance, err := h.instanceStore.Update(r.Context(), instanceID, req.Name, req.Host, req.Username, req.Password, req.BasicUsername, req.BasicPassword, req.TLSSkipVerify)
+	instance, err := h.instanceStore.Update(r.Context(), instanceID, req.Name, req.Host, req.Username, req.Password, req.BasicUsername, req.BasicPassword, req.TLSSkipVerify, req.AddDefaults, req.RateLimit, req.DisableFuzzySearch, req.Proxy, req.AllowDuplicate)
 	if err != nil {
 		if errors.Is(err, models.ErrInstanceNotFound) {
 			RespondError(w, http.StatusNotFound, "Instance not found")
 			return
 		}
+		if errors.Is(err, models.ErrDuplicateInstance) {
+			RespondError(w, http.StatusConflict, err.Error())
+			return
+		}
+		if errors.Is(err, models.ErrInvalidRateLimit) || errors.Is(err, models.ErrInvalidProxy) || errors.Is(err, models.ErrInvalidHost) {
+			RespondError(w, http.StatusBadRequest, err.Error())
+			return
+		}
 		log.Error().Err(err).Msg("Failed to update instance")
 		RespondError(w, http.StatusInternalServerError, "Failed to update instance")
 		return
@@ -303,6 +380,138 @@ func (h *InstancesHandler) UpdateInstance(w http.ResponseWriter, r *http.Request
 	RespondJSON(w, http.StatusOK, response)
 }
 
+// InstanceExport is a portable instance definition. Secrets are always redacted; they must be
+// supplied again when importing.
+type InstanceExport struct {
+	Name               string                     `json:"name"`
+	Host               string                     `json:"host"`
+	Username           string                     `json:"username"`
+	Password           string                     `json:"password"`
+	BasicUsername      *string                    `json:"basicUsername,omitempty"`
+	BasicPassword      *string                    `json:"basicPassword,omitempty"`
+	TLSSkipVerify      bool                       `json:"tlsSkipVerify"`
+	AddDefaults        models.InstanceAddDefaults `json:"addDefaults"`
+	RateLimit          float64                    `json:"rateLimit"`
+	DisableFuzzySearch bool                       `json:"disableFuzzySearch"`
+	Proxy              *models.InstanceProxy      `json:"proxy,omitempty"`
+}
+
+// ImportInstanceRequest represents a request to recreate an exported instance
+type ImportInstanceRequest struct {
+	InstanceExport
+	AllowDuplicate bool `json:"allowDuplicate,omitempty"`
+}
+
+// ExportInstance returns an instance definition without secrets
+func (h *InstancesHandler) ExportInstance(w http.ResponseWriter, r *http.Request) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return
+	}
+
+	instance, err := h.instanceStore.Get(r.Context(), instanceID)
+	if err != nil {
+		if errors.Is(err, models.ErrInstanceNotFound) {
+			RespondError(w, http.StatusNotFound, "Instance not found")
+			return
+		}
+		log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to fetch instance for export")
+		RespondError(w, http.StatusInternalServerError, "Failed to export instance")
+		return
+	}
+
+	export := InstanceExport{
+		Name:               instance.Name,
+		Host:               instance.Host,
+		Username:           instance.Username,
+		Password:           domain.RedactString(instance.PasswordEncrypted),
+		BasicUsername:      instance.BasicUsername,
+		TLSSkipVerify:      instance.TLSSkipVerify,
+		AddDefaults:        instance.AddDefaults,
+		RateLimit:          instance.RateLimit,
+		DisableFuzzySearch: instance.DisableFuzzySearch,
+		Proxy:              proxyResponse(instance),
+	}
+	if instance.BasicPasswordEncrypted != nil {
+		redacted := domain.RedactString(*instance.BasicPasswordEncrypted)
+		export.BasicPassword = &redacted
+	}
+
+	RespondJSON(w, http.StatusOK, export)
+}
+
+// ImportInstance creates an instance from an exported definition. Redacted passwords are
+// rejected so the caller can prompt for the real ones.
+func (h *InstancesHandler) ImportInstance(w http.ResponseWriter, r *http.Request) {
+	var req ImportInstanceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if req.Name == "" || req.Host == "" {
+		RespondError(w, http.StatusBadRequest, "Name and host are required")
+		return
+	}
+
+	if req.Password == "" || domain.IsRedactedString(req.Password) {
+		RespondError(w, http.StatusBadRequest, "Password is required to import an instance")
+		return
+	}
+
+	if req.BasicPassword != nil && domain.IsRedactedString(*req.BasicPassword) {
+		RespondError(w, http.StatusBadRequest, "Basic auth password is required to import an instance")
+		return
+	}
+
+	if req.RateLimit < 0 {
+		RespondError(w, http.StatusBadRequest, models.ErrInvalidRateLimit.Error())
+		return
+	}
+
+	if req.Proxy != nil {
+		if domain.IsRedactedString(req.Proxy.Password) {
+			RespondError(w, http.StatusBadRequest, "Proxy password is required to import an instance")
+			return
+		}
+		if err := req.Proxy.Validate(); err != nil {
+			RespondError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	instance, err := h.instanceStore.Create(r.Context(), req.Name, req.Host, req.Username, req.Password, req.BasicUsername, req.BasicPassword, req.TLSSkipVerify, req.AllowDuplicate)
+	if err != nil {
+		if errors.Is(err, models.ErrDuplicateInstance) {
+			RespondError(w, http.StatusConflict, err.Error())
+			return
+		}
+		log.Error().Err(err).Msg("Failed to import instance")
+		RespondError(w, http.StatusInternalServerError, "Failed to import instance")
+		return
+	}
+
+	// Settings that Create doesn't take are applied to the new instance in a second step
+	createdID := instance.ID
+	updated, err := h.instanceStore.Update(r.Context(), createdID, instance.Name, instance.Host, instance.Username, "", instance.BasicUsername, nil, nil, &req.AddDefaults, &req.RateLimit, &req.DisableFuzzySearch, req.Proxy, true)
+	if err != nil {
+		log.Error().Err(err).Int("instanceID", createdID).Msg("Failed to apply imported instance settings")
+		// Don't leave a half-imported instance behind; the import can simply be retried
+		if deleteErr := h.instanceStore.Delete(context.WithoutCancel(r.Context()), createdID); deleteErr != nil {
+			log.Error().Err(deleteErr).Int("instanceID", createdID).Msg("Failed to remove partially imported instance")
+		}
+		RespondError(w, http.StatusInternalServerError, "Failed to import instance settings")
+		return
+	}
+
+	response := h.buildQuickInstanceResponse(updated)
+
+	go h.testConnectionAsync(updated.ID)
+
+	RespondJSON(w, http.StatusCreated, response)
+}
+
 // DeleteInstance deletes an instance
 func (h *InstancesHandler) DeleteInstance(w http.ResponseWriter, r *http.Request) {
 	// Get instance ID from URL
@@ -368,3 +577,115 @@ func (h *InstancesHandler) TestConnection(w http.ResponseWriter, r *http.Request
 	}
 	RespondJSON(w, http.StatusOK, response)
 }
+
+// ResyncResponse represents the result of a forced resync
+type ResyncResponse struct {
+	LastSyncTime time.Time `json:"lastSyncTime"`
+}
+
+// GetInstanceVersion returns the qBittorrent version of an instance and the features it supports
+func (h *InstancesHandler) GetInstanceVersion(w http.ResponseWriter, r *http.Request) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return
+	}
+
+	version, err := h.syncManager.GetInstanceVersion(r.Context(), instanceID)
+	if err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to get instance version")
+		RespondError(w, http.StatusInternalServerError, "Failed to get instance version")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, version)
+}
+
+// GetCapabilities returns which features can be used against an instance's qBittorrent version
+func (h *InstancesHandler) GetCapabilities(w http.ResponseWriter, r *http.Request) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return
+	}
+
+	capabilities, err := h.syncManager.GetCapabilities(r.Context(), instanceID)
+	if err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to get instance capabilities")
+		RespondError(w, http.StatusInternalServerError, "Failed to get instance capabilities")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, capabilities)
+}
+
+// GetSyncHealth returns how current the instance's synced data is
+func (h *InstancesHandler) GetSyncHealth(w http.ResponseWriter, r *http.Request) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return
+	}
+
+	health, err := h.syncManager.GetSyncHealth(r.Context(), instanceID)
+	if err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to get sync health")
+		RespondError(w, http.StatusInternalServerError, "Failed to get sync health")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, health)
+}
+
+// ResyncInstance forces a full sync and drops optimistic state for an instance
+func (h *InstancesHandler) ResyncInstance(w http.ResponseWriter, r *http.Request) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return
+	}
+
+	lastSyncTime, err := h.syncManager.ForceResync(r.Context(), instanceID)
+	if err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to resync instance")
+		RespondError(w, http.StatusInternalServerError, "Failed to resync instance")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, ResyncResponse{LastSyncTime: lastSyncTime})
+}
+
+// InstancesHealthResponse represents the aggregated health of all instances
+type InstancesHealthResponse struct {
+	Status    string                               `json:"status"`
+	Instances []internalqbittorrent.InstanceHealth `json:"instances"`
+	Timestamp time.Time                            `json:"timestamp"`
+}
+
+// GetHealth returns overall and per-instance health for monitoring.
+// Responds with 503 when any instance is not healthy so uptime monitors can alert on status code alone.
+func (h *InstancesHandler) GetHealth(w http.ResponseWriter, r *http.Request) {
+	instances, err := h.clientPool.GetInstancesHealth(r.Context())
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get instances health")
+		RespondError(w, http.StatusInternalServerError, "Failed to get instances health")
+		return
+	}
+
+	response := InstancesHealthResponse{
+		Status:    "ok",
+		Instances: instances,
+		Timestamp: time.Now().UTC(),
+	}
+
+	status := http.StatusOK
+	for _, instance := range instances {
+		if instance.Status != internalqbittorrent.InstanceHealthOK {
+			response.Status = "degraded"
+			status = http.StatusServiceUnavailable
+			break
+		}
+	}
+
+	RespondJSON(w, status, response)
+}
@@ -0,0 +1,32 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// GetJob returns the progress of a background bulk operation, and its result once finished
+func (h *TorrentsHandler) GetJob(w http.ResponseWriter, r *http.Request) {
+	job := h.syncManager.GetJob(chi.URLParam(r, "id"))
+	if job == nil {
+		RespondError(w, http.StatusNotFound, "Job not found")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, job)
+}
+
+// CancelJob stops a background bulk operation before its next torrent
+func (h *TorrentsHandler) CancelJob(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if !h.syncManager.CancelJob(id) {
+		RespondError(w, http.StatusNotFound, "Job not found")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, h.syncManager.GetJob(id))
+}
@@ -18,12 +18,14 @@ import (
 // LicenseHandler handles license related HTTP requests
 type LicenseHandler struct {
 	licenseService *license.Service
+	scheduler      *license.RefreshScheduler
 }
 
 // NewLicenseHandler creates a new license handler
-func NewLicenseHandler(licenseService *license.Service) *LicenseHandler {
+func NewLicenseHandler(licenseService *license.Service, scheduler *license.RefreshScheduler) *LicenseHandler {
 	return &LicenseHandler{
 		licenseService: licenseService,
+		scheduler:      scheduler,
 	}
 }
 
@@ -74,6 +76,7 @@ func (h *LicenseHandler) Routes(r chi.Router) {
 	r.Post("/activate", h.ActivateLicense)
 	r.Post("/validate", h.ValidateLicense)
 	r.Post("/refresh", h.RefreshLicenses)
+	r.Get("/schedule", h.GetRefreshSchedule)
 	r.Delete("/{licenseKey}", h.DeleteLicense)
 }
 
@@ -289,6 +292,16 @@ func (h *LicenseHandler) RefreshLicenses(w http.ResponseWriter, r *http.Request)
 	})
 }
 
+// GetRefreshSchedule returns the state of the background license refresh
+func (h *LicenseHandler) GetRefreshSchedule(w http.ResponseWriter, r *http.Request) {
+	if h.scheduler == nil {
+		RespondError(w, http.StatusNotFound, "License refresh scheduler is not running")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, h.scheduler.Status())
+}
+
 // Helper function to mask license keys in logs
 func maskLicenseKey(key string) string {
 	if len(key) <= 8 {
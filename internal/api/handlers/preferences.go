@@ -4,7 +4,9 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"strconv"
 
@@ -149,3 +151,152 @@ func (h *PreferencesHandler) ToggleAlternativeSpeedLimits(w http.ResponseWriter,
 		return
 	}
 }
+
+// SetQueueLimitsRequest represents a request to change the queue limits of an instance
+type SetQueueLimitsRequest struct {
+	MaxActiveDownloads int `json:"maxActiveDownloads"`
+	MaxActiveUploads   int `json:"maxActiveUploads"`
+	MaxActiveTorrents  int `json:"maxActiveTorrents"`
+}
+
+// GetQueueLimits returns the maximum active downloads, uploads and torrents of an instance
+func (h *PreferencesHandler) GetQueueLimits(w http.ResponseWriter, r *http.Request) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		log.Error().Err(err).Msg("Invalid instance ID")
+		http.Error(w, "Invalid instance ID", http.StatusBadRequest)
+		return
+	}
+
+	limits, err := h.syncManager.GetQueueLimits(r.Context(), instanceID)
+	if err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to get queue limits")
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(limits); err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to encode queue limits response")
+		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+// SetQueueLimits updates only the queue limit preferences of an instance
+func (h *PreferencesHandler) SetQueueLimits(w http.ResponseWriter, r *http.Request) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		log.Error().Err(err).Msg("Invalid instance ID")
+		http.Error(w, "Invalid instance ID", http.StatusBadRequest)
+		return
+	}
+
+	var req SetQueueLimitsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Msg("Invalid request body")
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	limits, err := h.syncManager.SetQueueLimits(r.Context(), instanceID, req.MaxActiveDownloads, req.MaxActiveUploads, req.MaxActiveTorrents)
+	if errors.Is(err, qbittorrent.ErrInvalidQueueLimits) || errors.Is(err, qbittorrent.ErrQueueingDisabled) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to set queue limits")
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(limits); err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to encode queue limits response")
+		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+// SetSpeedLimitsRequest represents a request to change the speed limits of an instance in KB/s
+type SetSpeedLimitsRequest struct {
+	DownloadLimitKBs int64 `json:"downloadLimitKBs"`
+	UploadLimitKBs   int64 `json:"uploadLimitKBs"`
+}
+
+// GetGlobalSpeedLimits returns the global download and upload limits of an instance
+func (h *PreferencesHandler) GetGlobalSpeedLimits(w http.ResponseWriter, r *http.Request) {
+	h.getSpeedLimits(w, r, h.syncManager.GetGlobalSpeedLimits)
+}
+
+// SetGlobalSpeedLimits updates only the global speed limit preferences of an instance
+func (h *PreferencesHandler) SetGlobalSpeedLimits(w http.ResponseWriter, r *http.Request) {
+	h.setSpeedLimits(w, r, h.syncManager.SetGlobalSpeedLimits)
+}
+
+// GetAlternativeSpeedLimits returns the download and upload limits of alternative speed mode
+func (h *PreferencesHandler) GetAlternativeSpeedLimits(w http.ResponseWriter, r *http.Request) {
+	h.getSpeedLimits(w, r, h.syncManager.GetAlternativeSpeedLimits)
+}
+
+// SetAlternativeSpeedLimits updates the limits of alternative speed mode without toggling it
+func (h *PreferencesHandler) SetAlternativeSpeedLimits(w http.ResponseWriter, r *http.Request) {
+	h.setSpeedLimits(w, r, h.syncManager.SetAlternativeSpeedLimits)
+}
+
+func (h *PreferencesHandler) getSpeedLimits(w http.ResponseWriter, r *http.Request, get func(context.Context, int) (*qbittorrent.SpeedLimits, error)) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		log.Error().Err(err).Msg("Invalid instance ID")
+		http.Error(w, "Invalid instance ID", http.StatusBadRequest)
+		return
+	}
+
+	limits, err := get(r.Context(), instanceID)
+	if err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to get speed limits")
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(limits); err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to encode speed limits response")
+		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *PreferencesHandler) setSpeedLimits(w http.ResponseWriter, r *http.Request, set func(context.Context, int, int64, int64) (*qbittorrent.SpeedLimits, error)) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		log.Error().Err(err).Msg("Invalid instance ID")
+		http.Error(w, "Invalid instance ID", http.StatusBadRequest)
+		return
+	}
+
+	var req SetSpeedLimitsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Msg("Invalid request body")
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	limits, err := set(r.Context(), instanceID, req.DownloadLimitKBs, req.UploadLimitKBs)
+	if errors.Is(err, qbittorrent.ErrInvalidSpeedLimits) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to set speed limits")
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(limits); err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to encode speed limits response")
+		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
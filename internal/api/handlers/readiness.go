@@ -0,0 +1,34 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/autobrr/qui/internal/qbittorrent"
+)
+
+// warmingUpRetryAfter is the Retry-After hint, in seconds, for instances still loading their torrents
+const warmingUpRetryAfter = "2"
+
+// RequireInstanceReady responds 503 with a Retry-After hint until the instance's first sync has
+// completed, instead of an empty torrent list or a "no sync data" error. Checking readiness
+// retries the first sync, so an instance whose startup sync failed recovers on its own. Other
+// errors getting the client are left to the handler.
+func (h *TorrentsHandler) RequireInstanceReady(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+		if err == nil && errors.Is(h.syncManager.InstanceReady(r.Context(), instanceID), qbittorrent.ErrInstanceWarmingUp) {
+			w.Header().Set("Retry-After", warmingUpRetryAfter)
+			RespondError(w, http.StatusServiceUnavailable, "Instance is warming up, retry shortly")
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog/log"
+
+	"github.com/autobrr/qui/internal/qbittorrent"
+)
+
+// maxRecheckQueueConcurrency bounds how many torrents a recheck queue checks at once
+const maxRecheckQueueConcurrency = 50
+
+// StartRecheckQueueRequest selects torrents to recheck a few at a time
+type StartRecheckQueueRequest struct {
+	Hashes        []string `json:"hashes"`
+	MaxConcurrent int      `json:"maxConcurrent,omitempty"` // Defaults to 1
+}
+
+// StartRecheckQueue starts rechecking torrents in the background, a batch at a time
+func (h *TorrentsHandler) StartRecheckQueue(w http.ResponseWriter, r *http.Request) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return
+	}
+
+	var req StartRecheckQueueRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if len(req.Hashes) == 0 {
+		RespondError(w, http.StatusBadRequest, "At least one hash is required")
+		return
+	}
+
+	if req.MaxConcurrent < 0 || req.MaxConcurrent > maxRecheckQueueConcurrency {
+		RespondError(w, http.StatusBadRequest, "maxConcurrent must be between 1 and "+strconv.Itoa(maxRecheckQueueConcurrency))
+		return
+	}
+
+	status, err := h.syncManager.StartRecheckQueue(instanceID, req.Hashes, req.MaxConcurrent)
+	if errors.Is(err, qbittorrent.ErrRecheckQueueRunning) {
+		RespondError(w, http.StatusConflict, err.Error())
+		return
+	}
+	if err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to start recheck queue")
+		RespondError(w, http.StatusInternalServerError, "Failed to start recheck queue")
+		return
+	}
+
+	RespondJSON(w, http.StatusAccepted, status)
+}
+
+// GetRecheckQueue returns the progress of the instance's current or last recheck queue
+func (h *TorrentsHandler) GetRecheckQueue(w http.ResponseWriter, r *http.Request) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return
+	}
+
+	status := h.syncManager.GetRecheckQueue(instanceID)
+	if status == nil {
+		RespondError(w, http.StatusNotFound, "No recheck queue was started")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, status)
+}
+
+// CancelRecheckQueue stops the instance's recheck queue before its next batch
+func (h *TorrentsHandler) CancelRecheckQueue(w http.ResponseWriter, r *http.Request) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return
+	}
+
+	if !h.syncManager.CancelRecheckQueue(instanceID) {
+		RespondError(w, http.StatusNotFound, "No recheck queue was started")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, map[string]string{
+		"message": "Recheck queue cancelled",
+	})
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/autobrr/qui/internal/models"
+	"github.com/autobrr/qui/internal/qbittorrent"
+)
+
+// RefreshSettingsHandler manages how often clients should refresh instance data
+type RefreshSettingsHandler struct {
+	store       *models.RefreshSettingsStore
+	syncManager *qbittorrent.SyncManager
+}
+
+func NewRefreshSettingsHandler(store *models.RefreshSettingsStore, syncManager *qbittorrent.SyncManager) *RefreshSettingsHandler {
+	return &RefreshSettingsHandler{
+		store:       store,
+		syncManager: syncManager,
+	}
+}
+
+// RefreshSettings is the configured refresh interval along with the sync intervals it should
+// be aligned with
+type RefreshSettings struct {
+	// RefreshIntervalMs is the interval set by an admin; 0 follows the sync interval
+	RefreshIntervalMs int64 `json:"refreshIntervalMs"`
+	// RecommendedIntervalMs is the interval clients should poll at when no instance is selected
+	RecommendedIntervalMs int64 `json:"recommendedIntervalMs"`
+	MinRefreshIntervalMs  int64 `json:"minRefreshIntervalMs"`
+	MaxRefreshIntervalMs  int64 `json:"maxRefreshIntervalMs"`
+	SyncIntervalMs        int64 `json:"syncIntervalMs"`
+	// InstanceSyncIntervalsMs is the current sync interval of each connected instance, which is
+	// longer than SyncIntervalMs for instances with slow syncs
+	InstanceSyncIntervalsMs map[string]int64 `json:"instanceSyncIntervalsMs"`
+}
+
+// UpdateRefreshSettingsRequest represents a request to change the refresh interval
+type UpdateRefreshSettingsRequest struct {
+	RefreshIntervalMs int64 `json:"refreshIntervalMs"`
+}
+
+func (h *RefreshSettingsHandler) settings(interval time.Duration) RefreshSettings {
+	syncInterval := qbittorrent.DefaultSyncInterval()
+	recommended := interval
+	if recommended == 0 {
+		recommended = syncInterval
+	}
+
+	instances := make(map[string]int64)
+	for instanceID, instanceInterval := range h.syncManager.GetSyncIntervals() {
+		instances[strconv.Itoa(instanceID)] = instanceInterval.Milliseconds()
+	}
+
+	return RefreshSettings{
+		RefreshIntervalMs:       interval.Milliseconds(),
+		RecommendedIntervalMs:   recommended.Milliseconds(),
+		MinRefreshIntervalMs:    models.MinRefreshInterval.Milliseconds(),
+		MaxRefreshIntervalMs:    models.MaxRefreshInterval.Milliseconds(),
+		SyncIntervalMs:          syncInterval.Milliseconds(),
+		InstanceSyncIntervalsMs: instances,
+	}
+}
+
+// GetRefreshSettings returns the refresh interval and the current sync intervals
+func (h *RefreshSettingsHandler) GetRefreshSettings(w http.ResponseWriter, r *http.Request) {
+	interval, err := h.store.GetInterval(r.Context())
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get refresh settings")
+		RespondError(w, http.StatusInternalServerError, "Failed to get refresh settings")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, h.settings(interval))
+}
+
+// UpdateRefreshSettings stores a new refresh interval
+func (h *RefreshSettingsHandler) UpdateRefreshSettings(w http.ResponseWriter, r *http.Request) {
+	var req UpdateRefreshSettingsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	interval := time.Duration(req.RefreshIntervalMs) * time.Millisecond
+	if err := models.ValidateRefreshInterval(interval); err != nil {
+		RespondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := h.store.UpdateInterval(r.Context(), interval); err != nil {
+		log.Error().Err(err).Msg("Failed to update refresh settings")
+		RespondError(w, http.StatusInternalServerError, "Failed to update refresh settings")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, h.settings(interval))
+}
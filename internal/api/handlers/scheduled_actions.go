@@ -0,0 +1,235 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog/log"
+
+	"github.com/autobrr/qui/internal/models"
+	"github.com/autobrr/qui/internal/qbittorrent"
+)
+
+// ScheduledActionsHandler manages torrents that are resumed or stopped automatically at a set time
+type ScheduledActionsHandler struct {
+	scheduler *qbittorrent.ActionScheduler
+}
+
+func NewScheduledActionsHandler(scheduler *qbittorrent.ActionScheduler) *ScheduledActionsHandler {
+	return &ScheduledActionsHandler{
+		scheduler: scheduler,
+	}
+}
+
+// ScheduleActionRequest represents a request to resume or stop a torrent at a set time
+type ScheduleActionRequest struct {
+	RunAt time.Time `json:"runAt"`
+}
+
+// ScheduleResumeRequest represents a request to resume a torrent at a set time, as taken by the
+// scheduled-resume routes that predate scheduled stops
+type ScheduleResumeRequest struct {
+	ResumeAt time.Time `json:"resumeAt"`
+}
+
+// ScheduledResume is a scheduled resume as returned by the scheduled-resume routes
+type ScheduledResume struct {
+	ID         int       `json:"id"`
+	InstanceID int       `json:"instanceId"`
+	Hash       string    `json:"hash"`
+	ResumeAt   time.Time `json:"resumeAt"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+func newScheduledResume(schedule models.ScheduledAction) ScheduledResume {
+	return ScheduledResume{
+		ID:         schedule.ID,
+		InstanceID: schedule.InstanceID,
+		Hash:       schedule.Hash,
+		ResumeAt:   schedule.RunAt,
+		CreatedAt:  schedule.CreatedAt,
+	}
+}
+
+// ListScheduledActions returns the pending scheduled actions of an instance, optionally only
+// those of the action query parameter
+func (h *ScheduledActionsHandler) ListScheduledActions(w http.ResponseWriter, r *http.Request) {
+	action := r.URL.Query().Get("action")
+	if action != "" {
+		if err := models.ValidateScheduledAction(action); err != nil {
+			RespondError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	schedules, ok := h.list(w, r, action)
+	if !ok {
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, schedules)
+}
+
+// ListScheduledResumes returns the pending scheduled resumes of an instance
+func (h *ScheduledActionsHandler) ListScheduledResumes(w http.ResponseWriter, r *http.Request) {
+	schedules, ok := h.list(w, r, models.ScheduledActionResume)
+	if !ok {
+		return
+	}
+
+	resumes := make([]ScheduledResume, 0, len(schedules))
+	for _, schedule := range schedules {
+		resumes = append(resumes, newScheduledResume(schedule))
+	}
+	RespondJSON(w, http.StatusOK, resumes)
+}
+
+// ScheduleAction schedules a torrent to be resumed or stopped at a set time
+func (h *ScheduledActionsHandler) ScheduleAction(w http.ResponseWriter, r *http.Request) {
+	action, ok := parseScheduledAction(w, r)
+	if !ok {
+		return
+	}
+
+	var req ScheduleActionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	schedule, ok := h.schedule(w, r, action, "runAt", req.RunAt)
+	if !ok {
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, schedule)
+}
+
+// ScheduleResume schedules a torrent to be resumed at a set time
+func (h *ScheduledActionsHandler) ScheduleResume(w http.ResponseWriter, r *http.Request) {
+	var req ScheduleResumeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	schedule, ok := h.schedule(w, r, models.ScheduledActionResume, "resumeAt", req.ResumeAt)
+	if !ok {
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, newScheduledResume(*schedule))
+}
+
+// CancelScheduledAction removes the scheduled action of a torrent
+func (h *ScheduledActionsHandler) CancelScheduledAction(w http.ResponseWriter, r *http.Request) {
+	action, ok := parseScheduledAction(w, r)
+	if !ok {
+		return
+	}
+
+	h.cancel(w, r, action)
+}
+
+// CancelScheduledResume removes the scheduled resume of a torrent
+func (h *ScheduledActionsHandler) CancelScheduledResume(w http.ResponseWriter, r *http.Request) {
+	h.cancel(w, r, models.ScheduledActionResume)
+}
+
+// list loads the scheduled actions of the instance in the route, responding with an error when
+// that fails
+func (h *ScheduledActionsHandler) list(w http.ResponseWriter, r *http.Request, action string) ([]models.ScheduledAction, bool) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return nil, false
+	}
+
+	schedules, err := h.scheduler.ListScheduled(r.Context(), instanceID, action)
+	if err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Str("action", action).Msg("Failed to list scheduled actions")
+		RespondError(w, http.StatusInternalServerError, "Failed to list scheduled actions")
+		return nil, false
+	}
+
+	return schedules, true
+}
+
+// schedule schedules the action for the torrent in the route, responding with an error when that
+// fails. field names the request field runAt was read from.
+func (h *ScheduledActionsHandler) schedule(w http.ResponseWriter, r *http.Request, action, field string, runAt time.Time) (*models.ScheduledAction, bool) {
+	instanceID, hash, ok := parseScheduledTorrent(w, r)
+	if !ok {
+		return nil, false
+	}
+
+	if runAt.IsZero() {
+		RespondError(w, http.StatusBadRequest, field+" is required")
+		return nil, false
+	}
+
+	schedule, err := h.scheduler.Schedule(r.Context(), instanceID, hash, action, runAt)
+	if err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Str("hash", hash).Str("action", action).Msg("Failed to schedule action")
+		RespondError(w, http.StatusInternalServerError, "Failed to schedule "+action)
+		return nil, false
+	}
+
+	return schedule, true
+}
+
+// cancel removes the scheduled action of the torrent in the route and responds
+func (h *ScheduledActionsHandler) cancel(w http.ResponseWriter, r *http.Request, action string) {
+	instanceID, hash, ok := parseScheduledTorrent(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.scheduler.Cancel(r.Context(), instanceID, hash, action); err != nil {
+		if errors.Is(err, models.ErrScheduledActionNotFound) {
+			RespondError(w, http.StatusNotFound, "No "+action+" scheduled for this torrent")
+			return
+		}
+		log.Error().Err(err).Int("instanceID", instanceID).Str("hash", hash).Str("action", action).Msg("Failed to cancel scheduled action")
+		RespondError(w, http.StatusInternalServerError, "Failed to cancel scheduled "+action)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// parseScheduledTorrent reads the instance and torrent of a scheduled action route, responding
+// with an error when one is invalid
+func parseScheduledTorrent(w http.ResponseWriter, r *http.Request) (int, string, bool) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return 0, "", false
+	}
+
+	hash := chi.URLParam(r, "hash")
+	if hash == "" {
+		RespondError(w, http.StatusBadRequest, "Torrent hash is required")
+		return 0, "", false
+	}
+
+	return instanceID, hash, true
+}
+
+// parseScheduledAction reads the action of a scheduled action route, responding with an error
+// when it is invalid
+func parseScheduledAction(w http.ResponseWriter, r *http.Request) (string, bool) {
+	action := chi.URLParam(r, "action")
+	if err := models.ValidateScheduledAction(action); err != nil {
+		RespondError(w, http.StatusBadRequest, err.Error())
+		return "", false
+	}
+	return action, true
+}
@@ -0,0 +1,205 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog/log"
+
+	"github.com/autobrr/qui/internal/qbittorrent"
+)
+
+// SearchHandler proxies qBittorrent's search engine
+type SearchHandler struct {
+	syncManager *qbittorrent.SyncManager
+}
+
+func NewSearchHandler(syncManager *qbittorrent.SyncManager) *SearchHandler {
+	return &SearchHandler{
+		syncManager: syncManager,
+	}
+}
+
+// StartSearchRequest represents a request to start a search job
+type StartSearchRequest struct {
+	Pattern  string `json:"pattern"`
+	Category string `json:"category,omitempty"` // Defaults to "all"
+	Plugins  string `json:"plugins,omitempty"`  // "all", "enabled" or names separated by "|"
+}
+
+// AddSearchResultRequest represents a request to add a search result to the instance
+type AddSearchResultRequest struct {
+	URL      string `json:"url"`
+	Category string `json:"category,omitempty"`
+	Tags     string `json:"tags,omitempty"`
+	Paused   bool   `json:"paused,omitempty"`
+}
+
+// GetPlugins returns the installed search plugins
+func (h *SearchHandler) GetPlugins(w http.ResponseWriter, r *http.Request) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return
+	}
+
+	plugins, err := h.syncManager.GetSearchPlugins(r.Context(), instanceID)
+	if err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to get search plugins")
+		RespondError(w, http.StatusInternalServerError, "Failed to get search plugins")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, plugins)
+}
+
+// ListSearches returns the search jobs tracked for the instance
+func (h *SearchHandler) ListSearches(w http.ResponseWriter, r *http.Request) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, h.syncManager.GetSearchJobs(instanceID))
+}
+
+// StartSearch starts a new search job
+func (h *SearchHandler) StartSearch(w http.ResponseWriter, r *http.Request) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return
+	}
+
+	var req StartSearchRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if strings.TrimSpace(req.Pattern) == "" {
+		RespondError(w, http.StatusBadRequest, "Search pattern is required")
+		return
+	}
+
+	job, err := h.syncManager.StartSearch(r.Context(), instanceID, req.Pattern, req.Category, req.Plugins)
+	if err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to start search")
+		RespondError(w, http.StatusInternalServerError, "Failed to start search")
+		return
+	}
+
+	RespondJSON(w, http.StatusCreated, job)
+}
+
+// GetSearchResults returns results and progress of a search job
+func (h *SearchHandler) GetSearchResults(w http.ResponseWriter, r *http.Request) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return
+	}
+
+	searchID, err := strconv.Atoi(chi.URLParam(r, "searchID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid search ID")
+		return
+	}
+
+	limit := 0
+	if l := r.URL.Query().Get("limit"); l != "" {
+		if parsed, err := strconv.Atoi(l); err == nil && parsed > 0 {
+			limit = parsed
+		}
+	}
+
+	offset := 0
+	if o := r.URL.Query().Get("offset"); o != "" {
+		if parsed, err := strconv.Atoi(o); err == nil && parsed >= 0 {
+			offset = parsed
+		}
+	}
+
+	results, err := h.syncManager.GetSearchResults(r.Context(), instanceID, searchID, limit, offset)
+	if err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Int("searchID", searchID).Msg("Failed to get search results")
+		RespondError(w, http.StatusInternalServerError, "Failed to get search results")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, results)
+}
+
+// StopSearch stops a search job and frees it on qBittorrent
+func (h *SearchHandler) StopSearch(w http.ResponseWriter, r *http.Request) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return
+	}
+
+	searchID, err := strconv.Atoi(chi.URLParam(r, "searchID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid search ID")
+		return
+	}
+
+	if err := h.syncManager.StopSearch(r.Context(), instanceID, searchID); err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Int("searchID", searchID).Msg("Failed to stop search")
+		RespondError(w, http.StatusInternalServerError, "Failed to stop search")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, map[string]string{
+		"message": "Search stopped successfully",
+	})
+}
+
+// AddResult adds a search result's torrent URL to the instance
+func (h *SearchHandler) AddResult(w http.ResponseWriter, r *http.Request) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return
+	}
+
+	var req AddSearchResultRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if strings.TrimSpace(req.URL) == "" {
+		RespondError(w, http.StatusBadRequest, "URL is required")
+		return
+	}
+
+	options := make(map[string]string)
+	if req.Category != "" {
+		options["category"] = req.Category
+	}
+	if req.Tags != "" {
+		options["tags"] = req.Tags
+	}
+	if req.Paused {
+		options["paused"] = "true"
+		options["stopped"] = "true"
+	}
+
+	if err := h.syncManager.AddTorrentFromURLs(r.Context(), instanceID, []string{req.URL}, options); err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to add search result")
+		RespondError(w, http.StatusInternalServerError, "Failed to add torrent")
+		return
+	}
+
+	RespondJSON(w, http.StatusCreated, map[string]string{
+		"message": "Torrent added successfully",
+	})
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/autobrr/qui/internal/models"
+	"github.com/autobrr/qui/internal/qbittorrent"
+)
+
+// SearchSettingsHandler manages how torrent search results are ranked
+type SearchSettingsHandler struct {
+	store       *models.SearchSettingsStore
+	syncManager *qbittorrent.SyncManager
+}
+
+func NewSearchSettingsHandler(store *models.SearchSettingsStore, syncManager *qbittorrent.SyncManager) *SearchSettingsHandler {
+	return &SearchSettingsHandler{
+		store:       store,
+		syncManager: syncManager,
+	}
+}
+
+// GetSearchWeights returns the field weights used to rank search matches
+func (h *SearchSettingsHandler) GetSearchWeights(w http.ResponseWriter, r *http.Request) {
+	weights, err := h.store.GetWeights(r.Context())
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get search weights")
+		RespondError(w, http.StatusInternalServerError, "Failed to get search weights")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, weights)
+}
+
+// UpdateSearchWeights stores new field weights and applies them to subsequent searches
+func (h *SearchSettingsHandler) UpdateSearchWeights(w http.ResponseWriter, r *http.Request) {
+	var weights models.SearchWeights
+	if err := json.NewDecoder(r.Body).Decode(&weights); err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if err := weights.Validate(); err != nil {
+		RespondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := h.store.UpdateWeights(r.Context(), weights); err != nil {
+		log.Error().Err(err).Msg("Failed to update search weights")
+		RespondError(w, http.StatusInternalServerError, "Failed to update search weights")
+		return
+	}
+
+	h.syncManager.SetSearchWeights(weights)
+
+	RespondJSON(w, http.StatusOK, weights)
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog/log"
+
+	"github.com/autobrr/qui/internal/models"
+	"github.com/autobrr/qui/internal/qbittorrent"
+)
+
+// SidebarMetadataHandler manages the colors and order qui shows categories and tags in
+type SidebarMetadataHandler struct {
+	syncManager *qbittorrent.SyncManager
+}
+
+func NewSidebarMetadataHandler(syncManager *qbittorrent.SyncManager) *SidebarMetadataHandler {
+	return &SidebarMetadataHandler{
+		syncManager: syncManager,
+	}
+}
+
+// SetSidebarMetadataRequest sets the metadata of one category or tag. Names go in the body
+// since categories may contain slashes.
+type SetSidebarMetadataRequest struct {
+	Kind string `json:"kind"` // category or tag
+	Name string `json:"name"`
+	models.SidebarItemMetadata
+}
+
+// GetSidebarMetadata returns the category and tag metadata of an instance
+func (h *SidebarMetadataHandler) GetSidebarMetadata(w http.ResponseWriter, r *http.Request) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return
+	}
+
+	metadata, err := h.syncManager.GetSidebarMetadata(r.Context(), instanceID)
+	if err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to get sidebar metadata")
+		RespondError(w, http.StatusInternalServerError, "Failed to get sidebar metadata")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, metadata)
+}
+
+// SetSidebarMetadata stores the color and order of a category or tag
+func (h *SidebarMetadataHandler) SetSidebarMetadata(w http.ResponseWriter, r *http.Request) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return
+	}
+
+	var req SetSidebarMetadataRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	metadata, err := h.syncManager.SetSidebarItemMetadata(r.Context(), instanceID, req.Kind, req.Name, req.SidebarItemMetadata)
+	if err != nil {
+		if errors.Is(err, models.ErrInvalidSidebarMetadata) {
+			RespondError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to set sidebar metadata")
+		RespondError(w, http.StatusInternalServerError, "Failed to set sidebar metadata")
+		return
+	}
+
+	req.SidebarItemMetadata = metadata
+	RespondJSON(w, http.StatusOK, req)
+}
+
+// DeleteSidebarMetadata removes the metadata of the category or tag in the kind and name query parameters
+func (h *SidebarMetadataHandler) DeleteSidebarMetadata(w http.ResponseWriter, r *http.Request) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return
+	}
+
+	kind, name := r.URL.Query().Get("kind"), r.URL.Query().Get("name")
+	if err := h.syncManager.DeleteSidebarItemMetadata(r.Context(), instanceID, kind, name); err != nil {
+		if errors.Is(err, models.ErrSidebarMetadataNotFound) {
+			RespondError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to delete sidebar metadata")
+		RespondError(w, http.StatusInternalServerError, "Failed to delete sidebar metadata")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
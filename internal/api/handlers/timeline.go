@@ -0,0 +1,49 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog/log"
+
+	"github.com/autobrr/qui/internal/qbittorrent"
+)
+
+// TimelineHandler serves the recorded activity of torrents
+type TimelineHandler struct {
+	collector *qbittorrent.TimelineCollector
+}
+
+func NewTimelineHandler(collector *qbittorrent.TimelineCollector) *TimelineHandler {
+	return &TimelineHandler{
+		collector: collector,
+	}
+}
+
+// GetTorrentTimeline returns the state transitions recorded for a torrent, oldest first
+func (h *TimelineHandler) GetTorrentTimeline(w http.ResponseWriter, r *http.Request) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return
+	}
+
+	hash := chi.URLParam(r, "hash")
+	if hash == "" {
+		RespondError(w, http.StatusBadRequest, "Torrent hash is required")
+		return
+	}
+
+	events, err := h.collector.GetTorrentTimeline(r.Context(), instanceID, hash)
+	if err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Str("hash", hash).Msg("Failed to get torrent timeline")
+		RespondError(w, http.StatusInternalServerError, "Failed to get torrent timeline")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, events)
+}
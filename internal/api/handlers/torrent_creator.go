@@ -0,0 +1,136 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog/log"
+
+	"github.com/autobrr/qui/internal/qbittorrent"
+)
+
+// CreateTorrentRequest represents a request to create a .torrent file on the instance
+type CreateTorrentRequest struct {
+	SourcePath string `json:"sourcePath"`
+	qbittorrent.TorrentCreateOptions
+}
+
+// respondTorrentCreatorError maps torrent creator errors to responses, logging unexpected ones
+func respondTorrentCreatorError(w http.ResponseWriter, err error, instanceID int, message string) {
+	switch {
+	case errors.Is(err, qbittorrent.ErrTorrentCreatorUnsupported), errors.Is(err, qbittorrent.ErrInvalidTorrentCreation):
+		RespondError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, qbittorrent.ErrTorrentCreationTaskNotFound):
+		RespondError(w, http.StatusNotFound, "Torrent creation task not found")
+	case errors.Is(err, qbittorrent.ErrTorrentCreationNotFinished):
+		RespondError(w, http.StatusConflict, "Torrent creation task has not finished")
+	default:
+		log.Error().Err(err).Int("instanceID", instanceID).Msg(message)
+		RespondError(w, http.StatusInternalServerError, message)
+	}
+}
+
+// CreateTorrent starts a torrent creation job
+func (h *TorrentsHandler) CreateTorrent(w http.ResponseWriter, r *http.Request) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return
+	}
+
+	var req CreateTorrentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	taskID, err := h.syncManager.CreateTorrentFile(r.Context(), instanceID, req.SourcePath, req.TorrentCreateOptions)
+	if err != nil {
+		respondTorrentCreatorError(w, err, instanceID, "Failed to start torrent creation")
+		return
+	}
+
+	RespondJSON(w, http.StatusCreated, map[string]string{
+		"taskID": taskID,
+	})
+}
+
+// ListTorrentCreationTasks returns all torrent creation jobs of the instance
+func (h *TorrentsHandler) ListTorrentCreationTasks(w http.ResponseWriter, r *http.Request) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return
+	}
+
+	tasks, err := h.syncManager.GetTorrentCreationTasks(r.Context(), instanceID)
+	if err != nil {
+		respondTorrentCreatorError(w, err, instanceID, "Failed to get torrent creation tasks")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, tasks)
+}
+
+// GetTorrentCreationTask returns the status and progress of a torrent creation job
+func (h *TorrentsHandler) GetTorrentCreationTask(w http.ResponseWriter, r *http.Request) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return
+	}
+
+	task, err := h.syncManager.GetTorrentCreationTask(r.Context(), instanceID, chi.URLParam(r, "taskID"))
+	if err != nil {
+		respondTorrentCreatorError(w, err, instanceID, "Failed to get torrent creation task")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, task)
+}
+
+// DownloadCreatedTorrent returns the .torrent file of a finished creation job
+func (h *TorrentsHandler) DownloadCreatedTorrent(w http.ResponseWriter, r *http.Request) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return
+	}
+
+	taskID := chi.URLParam(r, "taskID")
+	data, err := h.syncManager.GetCreatedTorrentFile(r.Context(), instanceID, taskID)
+	if err != nil {
+		respondTorrentCreatorError(w, err, instanceID, "Failed to get created torrent file")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-bittorrent")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", taskID+".torrent"))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(data)
+}
+
+// DeleteTorrentCreationTask removes a torrent creation job and its result
+func (h *TorrentsHandler) DeleteTorrentCreationTask(w http.ResponseWriter, r *http.Request) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return
+	}
+
+	if err := h.syncManager.DeleteTorrentCreationTask(r.Context(), instanceID, chi.URLParam(r, "taskID")); err != nil {
+		respondTorrentCreatorError(w, err, instanceID, "Failed to delete torrent creation task")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, map[string]string{
+		"message": "Torrent creation task deleted",
+	})
+}
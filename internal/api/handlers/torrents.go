@@ -6,9 +6,11 @@ package handlers
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"path"
 	"slices"
 	"sort"
 	"strconv"
@@ -54,7 +56,7 @@ func (h *TorrentsHandler) ListTorrents(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Parse query parameters
-	limit := 300 // Default pagination size
+	limit := 0 // Default pagination size, applied by the sync manager
 	page := 0
 	sort := "addedOn"
 	order := "desc"
@@ -62,10 +64,11 @@ func (h *TorrentsHandler) ListTorrents(w http.ResponseWriter, r *http.Request) {
 	sessionID := r.Header.Get("X-Session-ID") // Optional session tracking
 
 	if l := r.URL.Query().Get("limit"); l != "" {
-		if parsed, err := strconv.Atoi(l); err == nil && parsed > 0 && parsed <= 2000 {
+		if parsed, err := strconv.Atoi(l); err == nil {
 			limit = parsed
 		}
 	}
+	limit = h.syncManager.PageSize(limit)
 
 	if p := r.URL.Query().Get("page"); p != "" {
 		if parsed, err := strconv.Atoi(p); err == nil && parsed >= 0 {
@@ -85,6 +88,26 @@ func (h *TorrentsHandler) ListTorrents(w http.ResponseWriter, r *http.Request) {
 		search = q
 	}
 
+	// Natural name order is opt-in; plain lexicographic order stays the default
+	naturalSort := r.URL.Query().Get("naturalSort") == "true"
+
+	// Optional column selection, e.g. fields=name,size,state; the full torrent is returned by default
+	fields, err := qbittorrent.ParseTorrentFields(r.URL.Query().Get("fields"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Optional IANA time zone for time range counts, e.g. the browser's; defaults to the configured zone
+	var timeZone *time.Location
+	if tz := r.URL.Query().Get("timezone"); tz != "" {
+		timeZone, err = qbittorrent.LoadTimeZone(tz)
+		if err != nil {
+			RespondError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
 	// Parse filters
 	var filters qbittorrent.FilterOptions
 
@@ -98,6 +121,7 @@ func (h *TorrentsHandler) ListTorrents(w http.ResponseWriter, r *http.Request) {
 	log.Debug().
 		Str("sort", sort).
 		Str("order", order).
+		Bool("naturalSort", naturalSort).
 		Int("page", page).
 		Int("limit", limit).
 		Str("search", search).
@@ -110,7 +134,7 @@ func (h *TorrentsHandler) ListTorrents(w http.ResponseWriter, r *http.Request) {
 
 	// Get torrents with search, sorting and filters
 	// The sync manager will handle stale-while-revalidate internally
-	response, err := h.syncManager.GetTorrentsWithFilters(r.Context(), instanceID, limit, offset, sort, order, search, filters)
+	response, err := h.syncManager.GetTorrentsWithFilters(r.Context(), instanceID, limit, offset, sort, order, naturalSort, search, filters)
 	if err != nil {
 		// Record error for user visibility
 		errorStore := h.syncManager.GetErrorStore()
@@ -123,12 +147,213 @@ func (h *TorrentsHandler) ListTorrents(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Time range counts are opt-in to keep the default payload lean
+	if r.URL.Query().Get("timeBuckets") == "true" && response.Counts != nil {
+		buckets, err := h.syncManager.GetTimeBucketCounts(r.Context(), instanceID, timeZone)
+		if err != nil {
+			log.Warn().Err(err).Int("instanceID", instanceID).Msg("Failed to get time bucket counts")
+		} else {
+			response.Counts.TimeBuckets = buckets
+		}
+	}
+
 	// Data is always fresh from sync manager
 	w.Header().Set("X-Data-Source", "fresh")
 
+	if fields != nil {
+		RespondJSON(w, http.StatusOK, projectedTorrentResponse{
+			TorrentResponse: response,
+			Torrents:        qbittorrent.ProjectTorrents(response.Torrents, fields),
+		})
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, response)
+}
+
+// projectedTorrentResponse is a TorrentResponse whose torrents only hold the requested fields
+type projectedTorrentResponse struct {
+	*qbittorrent.TorrentResponse
+	Torrents []map[string]any `json:"torrents"`
+}
+
+// SearchAllInstances searches torrents across all (or selected) instances
+func (h *TorrentsHandler) SearchAllInstances(w http.ResponseWriter, r *http.Request) {
+	query := strings.TrimSpace(r.URL.Query().Get("q"))
+	if query == "" {
+		RespondError(w, http.StatusBadRequest, "Search query is required")
+		return
+	}
+
+	instanceIDs, err := parseInstanceIDs(r)
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID in instances parameter")
+		return
+	}
+
+	limit := qbittorrent.DefaultGlobalSearchLimitPerInstance
+	if l := r.URL.Query().Get("limit"); l != "" {
+		if parsed, err := strconv.Atoi(l); err == nil && parsed > 0 && parsed <= 1000 {
+			limit = parsed
+		}
+	}
+
+	response, err := h.syncManager.SearchAllInstances(r.Context(), query, instanceIDs, limit)
+	if err != nil {
+		log.Error().Err(err).Str("search", query).Msg("Failed to search across instances")
+		RespondError(w, http.StatusInternalServerError, "Failed to search torrents")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, response)
+}
+
+// GetDashboardStats returns totals across all (or selected) instances with a per-instance breakdown
+func (h *TorrentsHandler) GetDashboardStats(w http.ResponseWriter, r *http.Request) {
+	instanceIDs, err := parseInstanceIDs(r)
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID in instances parameter")
+		return
+	}
+
+	stats, err := h.syncManager.GetDashboardStats(r.Context(), instanceIDs)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get dashboard stats")
+		RespondError(w, http.StatusInternalServerError, "Failed to get dashboard stats")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, stats)
+}
+
+// parseInstanceIDs reads the optional comma-separated instances query parameter
+func parseInstanceIDs(r *http.Request) ([]int, error) {
+	var instanceIDs []int
+	if ids := r.URL.Query().Get("instances"); ids != "" {
+		for idStr := range strings.SplitSeq(ids, ",") {
+			id, err := strconv.Atoi(strings.TrimSpace(idStr))
+			if err != nil {
+				return nil, err
+			}
+			instanceIDs = append(instanceIDs, id)
+		}
+	}
+	return instanceIDs, nil
+}
+
+// GetTopTorrents returns the top torrents of an instance for a single metric
+func (h *TorrentsHandler) GetTopTorrents(w http.ResponseWriter, r *http.Request) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return
+	}
+
+	metric := r.URL.Query().Get("metric")
+	if metric == "" {
+		metric = qbittorrent.TopMetricSize
+	}
+
+	if !qbittorrent.IsValidTopMetric(metric) {
+		RespondError(w, http.StatusBadRequest, "Invalid metric")
+		return
+	}
+
+	limit := qbittorrent.DefaultTopTorrentsLimit
+	if l := r.URL.Query().Get("limit"); l != "" {
+		if parsed, err := strconv.Atoi(l); err == nil && parsed > 0 && parsed <= 100 {
+			limit = parsed
+		}
+	}
+
+	response, err := h.syncManager.GetTopTorrents(r.Context(), instanceID, metric, limit)
+	if err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Str("metric", metric).Msg("Failed to get top torrents")
+		RespondError(w, http.StatusInternalServerError, "Failed to get top torrents")
+		return
+	}
+
 	RespondJSON(w, http.StatusOK, response)
 }
 
+// GetRecentlyCompleted returns the torrents that finished downloading most recently
+func (h *TorrentsHandler) GetRecentlyCompleted(w http.ResponseWriter, r *http.Request) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return
+	}
+
+	limit := qbittorrent.DefaultRecentlyCompletedLimit
+	if l := r.URL.Query().Get("limit"); l != "" {
+		if parsed, err := strconv.Atoi(l); err == nil && parsed > 0 && parsed <= 100 {
+			limit = parsed
+		}
+	}
+
+	torrents, err := h.syncManager.GetRecentlyCompleted(r.Context(), instanceID, limit)
+	if err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to get recently completed torrents")
+		RespondError(w, http.StatusInternalServerError, "Failed to get recently completed torrents")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, torrents)
+}
+
+// GetSeedingGoals returns how far the completed torrents are from the share limits that stop them seeding
+func (h *TorrentsHandler) GetSeedingGoals(w http.ResponseWriter, r *http.Request) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return
+	}
+
+	limit := qbittorrent.DefaultSeedingGoalsNearestLimit
+	if l := r.URL.Query().Get("limit"); l != "" {
+		if parsed, err := strconv.Atoi(l); err == nil && parsed > 0 && parsed <= 100 {
+			limit = parsed
+		}
+	}
+
+	goals, err := h.syncManager.GetSeedingGoals(r.Context(), instanceID, limit)
+	if err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to get seeding goals")
+		RespondError(w, http.StatusInternalServerError, "Failed to get seeding goals")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, goals)
+}
+
+// GetTorrentChanges returns the torrents added, modified and removed since the sync time in
+// the since query parameter, or every torrent when since is missing or too old to diff
+func (h *TorrentsHandler) GetTorrentChanges(w http.ResponseWriter, r *http.Request) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return
+	}
+
+	var since time.Time
+	if s := r.URL.Query().Get("since"); s != "" {
+		since, err = time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			RespondError(w, http.StatusBadRequest, "Invalid since timestamp")
+			return
+		}
+	}
+
+	changes, err := h.syncManager.GetTorrentChangesSince(r.Context(), instanceID, since)
+	if err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to get torrent changes")
+		RespondError(w, http.StatusInternalServerError, "Failed to get torrent changes")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, changes)
+}
+
 // AddTorrentRequest represents a request to add a torrent
 type AddTorrentRequest struct {
 	Category     string   `json:"category,omitempty"`
@@ -229,9 +454,10 @@ func (h *TorrentsHandler) AddTorrent(w http.ResponseWriter, r *http.Request) {
 				options["stopped"] = "false"
 			}
 		} else {
-			// Only set paused options if the requested state differs from the global preference
+			// Only set paused options if the requested state differs from the global preference,
+			// unless the instance has a paused default that would otherwise override the request
 			globalStartPaused := prefs.StartPausedEnabled
-			if requestedPaused != globalStartPaused {
+			if requestedPaused != globalStartPaused || h.syncManager.HasPausedAddDefault(ctx, instanceID) {
 				if requestedPaused {
 					options["paused"] = "true"
 					options["stopped"] = "true"
@@ -240,11 +466,15 @@ func (h *TorrentsHandler) AddTorrent(w http.ResponseWriter, r *http.Request) {
 					options["stopped"] = "false"
 				}
 			}
-			// If requestedPaused == globalStartPaused, don't set paused options
-			// This allows qBittorrent's global preference to take effect
+			// Otherwise don't set paused options so qBittorrent's global preference takes effect
 		}
 	}
 
+	// Session cookie for URL adds from sites that require a login; never logged
+	if cookie := r.FormValue("cookie"); cookie != "" && len(urls) > 0 {
+		options["cookie"] = cookie
+	}
+
 	if skipChecking := r.FormValue("skip_checking"); skipChecking == "true" {
 		options["skip_checking"] = "true"
 	}
@@ -328,6 +558,10 @@ func (h *TorrentsHandler) AddTorrent(w http.ResponseWriter, r *http.Request) {
 	} else if len(urls) > 0 {
 		// Add from URLs
 		if err := h.syncManager.AddTorrentFromURLs(ctx, instanceID, urls, options); err != nil {
+			if errors.Is(err, qbittorrent.ErrInvalidCookie) {
+				RespondError(w, http.StatusBadRequest, err.Error())
+				return
+			}
 			log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to add torrent from URLs")
 			RespondError(w, http.StatusInternalServerError, "Failed to add torrent")
 			return
@@ -367,9 +601,11 @@ type BulkActionRequest struct {
 	Hashes                   []string                   `json:"hashes"`
 	Action                   string                     `json:"action"`
 	DeleteFiles              bool                       `json:"deleteFiles,omitempty"`              // For delete action
-	Tags                     string                     `json:"tags,omitempty"`                     // For tag operations (comma-separated)
+	Force                    bool                       `json:"force,omitempty"`                    // Delete files even if shared with cross-seeds
+	Tags                     string                     `json:"tags,omitempty"`                     // Deprecated: comma-separated tags, use TagList
+	TagList                  []string                   `json:"tagList,omitempty"`                  // For tag operations
 	Category                 string                     `json:"category,omitempty"`                 // For category operations
-	Enable                   bool                       `json:"enable,omitempty"`                   // For toggleAutoTMM action
+	Enable                   bool                       `json:"enable,omitempty"`                   // For toggleAutoTMM and setCategoryAutoTMM actions
 	SelectAll                bool                       `json:"selectAll,omitempty"`                // When true, apply to all torrents matching filters
 	Filters                  *qbittorrent.FilterOptions `json:"filters,omitempty"`                  // Filters to apply when selectAll is true
 	Search                   string                     `json:"search,omitempty"`                   // Search query when selectAll is true
@@ -383,6 +619,34 @@ type BulkActionRequest struct {
 	TrackerOldURL            string                     `json:"trackerOldURL,omitempty"`            // For editTrackers action
 	TrackerNewURL            string                     `json:"trackerNewURL,omitempty"`            // For editTrackers action
 	TrackerURLs              string                     `json:"trackerURLs,omitempty"`              // For addTrackers/removeTrackers actions
+	TrackerListURL           string                     `json:"trackerListURL,omitempty"`           // For addTrackersFromList action
+	AllowPrivate             bool                       `json:"allowPrivate,omitempty"`             // Allow forceReannounceAll on private torrents
+	Async                    bool                       `json:"async,omitempty"`                    // Run editTrackers/addTrackers/removeTrackers as a job
+}
+
+// tagList returns the tags of a tag operation, falling back to the deprecated comma-separated form
+func (req *BulkActionRequest) tagList() []string {
+	if req.TagList != nil {
+		return req.TagList
+	}
+	return qbittorrent.SplitTags(req.Tags)
+}
+
+// startTrackerJob runs a tracker bulk action in the background and responds with its job
+func (h *TorrentsHandler) startTrackerJob(w http.ResponseWriter, instanceID int, hashes []string, req *BulkActionRequest) {
+	job, err := h.syncManager.StartTrackerJob(instanceID, hashes, qbittorrent.TrackerJobRequest{
+		Action: req.Action,
+		OldURL: req.TrackerOldURL,
+		NewURL: req.TrackerNewURL,
+		URLs:   req.TrackerURLs,
+	})
+	if err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Str("action", req.Action).Msg("Failed to start tracker job")
+		RespondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	RespondJSON(w, http.StatusAccepted, job)
 }
 
 // BulkAction performs bulk operations on torrents
@@ -412,11 +676,11 @@ func (h *TorrentsHandler) BulkAction(w http.ResponseWriter, r *http.Request) {
 	}
 
 	validActions := []string{
-		"pause", "resume", "delete", "deleteWithFiles",
-		"recheck", "reannounce", "increasePriority", "decreasePriority",
+		"pause", "resume", "resumeForce", "delete", "deleteWithFiles",
+		"recheck", "reannounce", "forceReannounceAll", "increasePriority", "decreasePriority",
 		"topPriority", "bottomPriority", "addTags", "removeTags", "setTags", "setCategory",
-		"toggleAutoTMM", "setShareLimit", "setUploadLimit", "setDownloadLimit", "setLocation",
-		"editTrackers", "addTrackers", "removeTrackers",
+		"toggleAutoTMM", "setCategoryAutoTMM", "setShareLimit", "setUploadLimit", "setDownloadLimit", "setLocation",
+		"editTrackers", "addTrackers", "addTrackersFromList", "removeTrackers",
 	}
 
 	valid := slices.Contains(validActions, req.Action)
@@ -434,9 +698,8 @@ func (h *TorrentsHandler) BulkAction(w http.ResponseWriter, r *http.Request) {
 			req.Filters = &qbittorrent.FilterOptions{}
 		}
 
-		// Get all torrents matching the current filters and search
-		// Use a very large limit to get all torrents (backend will handle this properly)
-		response, err := h.syncManager.GetTorrentsWithFilters(r.Context(), instanceID, 100000, 0, "added_on", "desc", req.Search, *req.Filters)
+		// Get all torrents matching the current filters and search, minus the excluded ones
+		targetHashes, err = h.syncManager.ResolveHashesByFilter(r.Context(), instanceID, req.Search, *req.Filters, req.ExcludeHashes)
 		if err != nil {
 			// Record error for user visibility
 			errorStore := h.syncManager.GetErrorStore()
@@ -449,19 +712,7 @@ func (h *TorrentsHandler) BulkAction(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
-		// Extract all hashes and filter out excluded ones
-		excludeSet := make(map[string]bool)
-		for _, hash := range req.ExcludeHashes {
-			excludeSet[hash] = true
-		}
-
-		for _, torrent := range response.Torrents {
-			if !excludeSet[torrent.Hash] {
-				targetHashes = append(targetHashes, torrent.Hash)
-			}
-		}
-
-		log.Debug().Int("instanceID", instanceID).Int("totalFound", len(response.Torrents)).Int("excluded", len(req.ExcludeHashes)).Int("targetCount", len(targetHashes)).Str("action", req.Action).Msg("SelectAll bulk action")
+		log.Debug().Int("instanceID", instanceID).Int("excluded", len(req.ExcludeHashes)).Int("targetCount", len(targetHashes)).Str("action", req.Action).Msg("SelectAll bulk action")
 	} else {
 		targetHashes = req.Hashes
 	}
@@ -471,27 +722,38 @@ func (h *TorrentsHandler) BulkAction(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Keep files that other torrents still use unless the caller explicitly forces deletion
+	var deleteResult *qbittorrent.DeleteResult
+	// Torrents skipped by the reannounce guard; nil for other actions
+	var reannounceSkipped *int
+	var forceStartImpact *qbittorrent.ForceStartImpact
+	// Per-torrent outcome of tracker edits; nil for other actions
+	var trackerResults []qbittorrent.TrackerResult
+	var trackerListResult *qbittorrent.TrackerListResult
+
 	// Perform bulk action based on type
 	switch req.Action {
 	case "addTags":
-		if req.Tags == "" {
+		if len(req.tagList()) == 0 {
 			RespondError(w, http.StatusBadRequest, "Tags parameter is required for addTags action")
 			return
 		}
-		err = h.syncManager.AddTags(r.Context(), instanceID, targetHashes, req.Tags)
+		err = h.syncManager.AddTagList(r.Context(), instanceID, targetHashes, req.tagList())
 	case "removeTags":
-		if req.Tags == "" {
+		if len(req.tagList()) == 0 {
 			RespondError(w, http.StatusBadRequest, "Tags parameter is required for removeTags action")
 			return
 		}
-		err = h.syncManager.RemoveTags(r.Context(), instanceID, targetHashes, req.Tags)
+		err = h.syncManager.RemoveTagList(r.Context(), instanceID, targetHashes, req.tagList())
 	case "setTags":
 		// allow empty tags to clear all tags from torrents
-		err = h.syncManager.SetTags(r.Context(), instanceID, targetHashes, req.Tags)
+		err = h.syncManager.SetTagList(r.Context(), instanceID, targetHashes, req.tagList())
 	case "setCategory":
 		err = h.syncManager.SetCategory(r.Context(), instanceID, targetHashes, req.Category)
 	case "toggleAutoTMM":
 		err = h.syncManager.SetAutoTMM(r.Context(), instanceID, targetHashes, req.Enable)
+	case "setCategoryAutoTMM":
+		err = h.syncManager.SetCategoryWithAutoTMM(r.Context(), instanceID, targetHashes, req.Category, req.Enable)
 	case "setShareLimit":
 		err = h.syncManager.SetTorrentShareLimit(r.Context(), instanceID, targetHashes, req.RatioLimit, req.SeedingTimeLimit, req.InactiveSeedingTimeLimit)
 	case "setUploadLimit":
@@ -509,31 +771,81 @@ func (h *TorrentsHandler) BulkAction(w http.ResponseWriter, r *http.Request) {
 			RespondError(w, http.StatusBadRequest, "Both trackerOldURL and trackerNewURL are required for editTrackers action")
 			return
 		}
-		err = h.syncManager.BulkEditTrackers(r.Context(), instanceID, targetHashes, req.TrackerOldURL, req.TrackerNewURL)
+		if req.Async {
+			h.startTrackerJob(w, instanceID, targetHashes, &req)
+			return
+		}
+		trackerResults, err = h.syncManager.BulkEditTrackers(r.Context(), instanceID, targetHashes, req.TrackerOldURL, req.TrackerNewURL)
 	case "addTrackers":
 		if req.TrackerURLs == "" {
 			RespondError(w, http.StatusBadRequest, "TrackerURLs parameter is required for addTrackers action")
 			return
 		}
-		err = h.syncManager.BulkAddTrackers(r.Context(), instanceID, targetHashes, req.TrackerURLs)
+		if req.Async {
+			h.startTrackerJob(w, instanceID, targetHashes, &req)
+			return
+		}
+		trackerResults, err = h.syncManager.BulkAddTrackers(r.Context(), instanceID, targetHashes, req.TrackerURLs)
+	case "addTrackersFromList":
+		if req.TrackerListURL == "" {
+			RespondError(w, http.StatusBadRequest, "TrackerListURL parameter is required for addTrackersFromList action")
+			return
+		}
+		trackerListResult, err = h.syncManager.BulkAddTrackersFromList(r.Context(), instanceID, targetHashes, req.TrackerListURL)
 	case "removeTrackers":
 		if req.TrackerURLs == "" {
 			RespondError(w, http.StatusBadRequest, "TrackerURLs parameter is required for removeTrackers action")
 			return
 		}
-		err = h.syncManager.BulkRemoveTrackers(r.Context(), instanceID, targetHashes, req.TrackerURLs)
-	case "delete":
+		if req.Async {
+			h.startTrackerJob(w, instanceID, targetHashes, &req)
+			return
+		}
+		trackerResults, err = h.syncManager.BulkRemoveTrackers(r.Context(), instanceID, targetHashes, req.TrackerURLs)
+	case "delete", "deleteWithFiles":
 		// Handle delete with deleteFiles parameter
-		action := req.Action
-		if req.DeleteFiles {
+		action := "delete"
+		if req.DeleteFiles || req.Action == "deleteWithFiles" {
 			action = "deleteWithFiles"
 		}
-		err = h.syncManager.BulkAction(r.Context(), instanceID, targetHashes, action)
+		if action == "deleteWithFiles" && !req.Force {
+			deleteResult, err = h.syncManager.DeleteTorrentsPreservingCrossSeeds(r.Context(), instanceID, targetHashes)
+		} else {
+			err = h.syncManager.BulkAction(r.Context(), instanceID, targetHashes, action)
+		}
+	case "reannounce":
+		var skipped int
+		skipped, err = h.syncManager.Reannounce(r.Context(), instanceID, targetHashes)
+		reannounceSkipped = &skipped
+	case "forceReannounceAll":
+		var skipped int
+		skipped, err = h.syncManager.ForceReannounceAll(r.Context(), instanceID, targetHashes, req.AllowPrivate)
+		reannounceSkipped = &skipped
+	case "resumeForce":
+		// Measured before the force start applies; the impact is informational, so failing to
+		// compute it doesn't stop the action
+		var impactErr error
+		forceStartImpact, impactErr = h.syncManager.GetForceStartImpact(r.Context(), instanceID, targetHashes)
+		if impactErr != nil {
+			log.Warn().Err(impactErr).Int("instanceID", instanceID).Msg("Failed to compute force start impact")
+		}
+		err = h.syncManager.BulkAction(r.Context(), instanceID, targetHashes, req.Action)
 	default:
 		// Handle other standard actions
 		err = h.syncManager.BulkAction(r.Context(), instanceID, targetHashes, req.Action)
 	}
 
+	var privateErr *qbittorrent.PrivateTorrentsError
+	if errors.As(err, &privateErr) {
+		respondPrivateTorrents(w, privateErr)
+		return
+	}
+
+	if errors.Is(err, qbittorrent.ErrInvalidTag) || errors.Is(err, qbittorrent.ErrInvalidTrackerList) || errors.Is(err, qbittorrent.ErrCategoryNotFound) {
+		RespondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
 	if err != nil {
 		log.Error().Err(err).Int("instanceID", instanceID).Str("action", req.Action).Msg("Failed to perform bulk action")
 		RespondError(w, http.StatusInternalServerError, "Failed to perform bulk action")
@@ -542,111 +854,449 @@ func (h *TorrentsHandler) BulkAction(w http.ResponseWriter, r *http.Request) {
 
 	log.Debug().Int("instanceID", instanceID).Str("action", req.Action).Msg("Bulk action completed with optimistic cache update")
 
+	if deleteResult != nil && len(deleteResult.Downgraded) > 0 {
+		RespondJSON(w, http.StatusOK, map[string]any{
+			"message":    "Bulk action completed, some torrents were deleted without files to protect cross-seeds",
+			"downgraded": deleteResult.Downgraded,
+		})
+		return
+	}
+
+	if trackerResults != nil {
+		message := "Bulk action completed successfully"
+		if failed := countFailedTrackerResults(trackerResults); failed > 0 {
+			message = fmt.Sprintf("Bulk action completed, %d of %d torrents failed", failed, len(trackerResults))
+		}
+		RespondJSON(w, http.StatusOK, map[string]any{
+			"message": message,
+			"results": trackerResults,
+		})
+		return
+	}
+
+	if trackerListResult != nil {
+		RespondJSON(w, http.StatusOK, map[string]any{
+			"message":        "Bulk action completed successfully",
+			"skippedPrivate": trackerListResult.SkippedPrivate,
+		})
+		return
+	}
+
+	if reannounceSkipped != nil {
+		RespondJSON(w, http.StatusOK, map[string]any{
+			"message": "Bulk action completed successfully",
+			"skipped": *reannounceSkipped,
+		})
+		return
+	}
+
+	if forceStartImpact != nil {
+		RespondJSON(w, http.StatusOK, map[string]any{
+			"message":    "Bulk action completed successfully",
+			"forceStart": forceStartImpact,
+		})
+		return
+	}
+
 	RespondJSON(w, http.StatusOK, map[string]string{
 		"message": "Bulk action completed successfully",
 	})
 }
 
-// GetCategories returns all categories
-func (h *TorrentsHandler) GetCategories(w http.ResponseWriter, r *http.Request) {
-	// Get instance ID from URL
+// GetTrackedPaths returns the save and content paths of the instance's torrents, for finding
+// orphaned files with an external tool
+func (h *TorrentsHandler) GetTrackedPaths(w http.ResponseWriter, r *http.Request) {
 	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
 	if err != nil {
 		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
 		return
 	}
 
-	// Get categories
-	categories, err := h.syncManager.GetCategories(r.Context(), instanceID)
+	paths, err := h.syncManager.GetTrackedPaths(r.Context(), instanceID)
 	if err != nil {
-		log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to get categories")
-		RespondError(w, http.StatusInternalServerError, "Failed to get categories")
+		log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to get tracked paths")
+		RespondError(w, http.StatusInternalServerError, "Failed to get tracked paths")
 		return
 	}
 
-	RespondJSON(w, http.StatusOK, categories)
+	RespondJSON(w, http.StatusOK, paths)
 }
 
-// CreateCategory creates a new category
-func (h *TorrentsHandler) CreateCategory(w http.ResponseWriter, r *http.Request) {
-	// Get instance ID from URL
+func countFailedTrackerResults(results []qbittorrent.TrackerResult) int {
+	failed := 0
+	for _, result := range results {
+		if !result.Success {
+			failed++
+		}
+	}
+	return failed
+}
+
+// PauseAll pauses every torrent on an instance
+func (h *TorrentsHandler) PauseAll(w http.ResponseWriter, r *http.Request) {
+	h.setAllPaused(w, r, true)
+}
+
+// ResumeAll resumes every torrent on an instance
+func (h *TorrentsHandler) ResumeAll(w http.ResponseWriter, r *http.Request) {
+	h.setAllPaused(w, r, false)
+}
+
+func (h *TorrentsHandler) setAllPaused(w http.ResponseWriter, r *http.Request, pause bool) {
 	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
 	if err != nil {
 		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
 		return
 	}
 
-	var req struct {
-		Name     string `json:"name"`
-		SavePath string `json:"savePath"`
+	var affected int
+	if pause {
+		affected, err = h.syncManager.PauseAll(r.Context(), instanceID)
+	} else {
+		affected, err = h.syncManager.ResumeAll(r.Context(), instanceID)
 	}
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		RespondError(w, http.StatusBadRequest, "Invalid request body")
+	if err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Bool("pause", pause).Msg("Failed to pause or resume all torrents")
+		RespondError(w, http.StatusInternalServerError, "Failed to update torrents")
 		return
 	}
 
-	if req.Name == "" {
-		RespondError(w, http.StatusBadRequest, "Category name is required")
+	RespondJSON(w, http.StatusOK, map[string]int{
+		"affected": affected,
+	})
+}
+
+// RecoverErrored rechecks and resumes every errored torrent on an instance
+func (h *TorrentsHandler) RecoverErrored(w http.ResponseWriter, r *http.Request) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
 		return
 	}
 
-	if err := h.syncManager.CreateCategory(r.Context(), instanceID, req.Name, req.SavePath); err != nil {
-		log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to create category")
-		RespondError(w, http.StatusInternalServerError, "Failed to create category")
+	hashes, err := h.syncManager.RecoverErrored(r.Context(), instanceID)
+	if err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to recover errored torrents")
+		RespondError(w, http.StatusInternalServerError, "Failed to recover errored torrents")
 		return
 	}
 
-	RespondJSON(w, http.StatusCreated, map[string]string{
-		"message": "Category created successfully",
+	RespondJSON(w, http.StatusOK, map[string][]string{
+		"hashes": hashes,
 	})
 }
 
-// EditCategory edits an existing category
-func (h *TorrentsHandler) EditCategory(w http.ResponseWriter, r *http.Request) {
-	// Get instance ID from URL
+// Bounds for how long RecheckAndResume waits; the upper bound stays below the server write timeout
+const (
+	defaultRecheckResumeWait = 60 * time.Second
+	maxRecheckResumeWait     = 90 * time.Second
+)
+
+// RecheckAndResumeRequest selects torrents to recheck and resume if complete
+type RecheckAndResumeRequest struct {
+	Hashes         []string `json:"hashes"`
+	MaxWaitSeconds int      `json:"maxWaitSeconds,omitempty"`
+}
+
+// RecheckAndResume rechecks torrents and resumes only those that come back complete
+func (h *TorrentsHandler) RecheckAndResume(w http.ResponseWriter, r *http.Request) {
 	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
 	if err != nil {
 		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
 		return
 	}
 
-	var req struct {
-		Name     string `json:"name"`
-		SavePath string `json:"savePath"`
-	}
+	var req RecheckAndResumeRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		RespondError(w, http.StatusBadRequest, "Invalid request body")
 		return
 	}
 
-	if req.Name == "" {
-		RespondError(w, http.StatusBadRequest, "Category name is required")
+	if len(req.Hashes) == 0 {
+		RespondError(w, http.StatusBadRequest, "At least one hash is required")
 		return
 	}
 
-	if err := h.syncManager.EditCategory(r.Context(), instanceID, req.Name, req.SavePath); err != nil {
-		log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to edit category")
-		RespondError(w, http.StatusInternalServerError, "Failed to edit category")
+	maxWait := defaultRecheckResumeWait
+	if req.MaxWaitSeconds > 0 {
+		maxWait = min(time.Duration(req.MaxWaitSeconds)*time.Second, maxRecheckResumeWait)
+	}
+
+	outcomes, err := h.syncManager.RecheckAndResumeIfComplete(r.Context(), instanceID, req.Hashes, maxWait)
+	if err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to recheck and resume torrents")
+		RespondError(w, http.StatusInternalServerError, "Failed to recheck and resume torrents")
 		return
 	}
 
-	RespondJSON(w, http.StatusOK, map[string]string{
-		"message": "Category updated successfully",
+	RespondJSON(w, http.StatusOK, map[string]map[string]string{
+		"outcomes": outcomes,
 	})
 }
 
-// RemoveCategories removes categories
-func (h *TorrentsHandler) RemoveCategories(w http.ResponseWriter, r *http.Request) {
-	// Get instance ID from URL
+// SetLocationAndRecheckRequest selects torrents to move and recheck at the new location
+type SetLocationAndRecheckRequest struct {
+	Hashes         []string `json:"hashes"`
+	Location       string   `json:"location"`
+	MaxWaitSeconds int      `json:"maxWaitSeconds,omitempty"`
+}
+
+// SetLocationAndRecheck moves torrents and rechecks them once the move has registered
+func (h *TorrentsHandler) SetLocationAndRecheck(w http.ResponseWriter, r *http.Request) {
 	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
 	if err != nil {
 		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
 		return
 	}
 
-	var req struct {
-		Categories []string `json:"categories"`
-	}
+	var req SetLocationAndRecheckRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if len(req.Hashes) == 0 {
+		RespondError(w, http.StatusBadRequest, "At least one hash is required")
+		return
+	}
+
+	if strings.TrimSpace(req.Location) == "" {
+		RespondError(w, http.StatusBadRequest, "Location is required")
+		return
+	}
+
+	maxWait := defaultRecheckResumeWait
+	if req.MaxWaitSeconds > 0 {
+		maxWait = min(time.Duration(req.MaxWaitSeconds)*time.Second, maxRecheckResumeWait)
+	}
+
+	outcomes, err := h.syncManager.SetLocationAndRecheck(r.Context(), instanceID, req.Hashes, req.Location, maxWait)
+	if err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to set location and recheck torrents")
+		RespondError(w, http.StatusInternalServerError, "Failed to set location and recheck torrents")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, map[string]map[string]string{
+		"outcomes": outcomes,
+	})
+}
+
+// TorrentHashesRequest selects torrents by hash
+type TorrentHashesRequest struct {
+	Hashes []string `json:"hashes"`
+}
+
+// ExportMagnets returns magnet links for the selected torrents
+func (h *TorrentsHandler) ExportMagnets(w http.ResponseWriter, r *http.Request) {
+	instanceID, hashes, ok := decodeTorrentHashes(w, r)
+	if !ok {
+		return
+	}
+
+	magnets, err := h.syncManager.GetMagnetLinks(r.Context(), instanceID, hashes)
+	if err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to build magnet links")
+		RespondError(w, http.StatusInternalServerError, "Failed to build magnet links")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, magnets)
+}
+
+// ExportHashes returns the infohashes of the selected torrents
+func (h *TorrentsHandler) ExportHashes(w http.ResponseWriter, r *http.Request) {
+	instanceID, hashes, ok := decodeTorrentHashes(w, r)
+	if !ok {
+		return
+	}
+
+	infohashes, err := h.syncManager.GetInfohashes(r.Context(), instanceID, hashes)
+	if err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to get infohashes")
+		RespondError(w, http.StatusInternalServerError, "Failed to get infohashes")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, map[string][]string{
+		"hashes": infohashes,
+	})
+}
+
+// FindDuplicateTrackers returns the torrents that have the same tracker under more than one URL
+func (h *TorrentsHandler) FindDuplicateTrackers(w http.ResponseWriter, r *http.Request) {
+	instanceID, hashes, ok := decodeTorrentHashes(w, r)
+	if !ok {
+		return
+	}
+
+	duplicates, err := h.syncManager.FindDuplicateTrackers(r.Context(), instanceID, hashes)
+	if err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to find duplicate trackers")
+		RespondError(w, http.StatusInternalServerError, "Failed to find duplicate trackers")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, duplicates)
+}
+
+// GetTorrentLimits returns the speed limits set on torrents, keyed by hash
+func (h *TorrentsHandler) GetTorrentLimits(w http.ResponseWriter, r *http.Request) {
+	instanceID, hashes, ok := decodeTorrentHashes(w, r)
+	if !ok {
+		return
+	}
+
+	limits, err := h.syncManager.GetTorrentLimits(r.Context(), instanceID, hashes)
+	if err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to get torrent limits")
+		RespondError(w, http.StatusInternalServerError, "Failed to get torrent limits")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, limits)
+}
+
+// DeduplicateTrackers removes the duplicate trackers of torrents and reports what was removed
+func (h *TorrentsHandler) DeduplicateTrackers(w http.ResponseWriter, r *http.Request) {
+	instanceID, hashes, ok := decodeTorrentHashes(w, r)
+	if !ok {
+		return
+	}
+
+	removed, err := h.syncManager.DeduplicateTrackers(r.Context(), instanceID, hashes)
+	if err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to deduplicate trackers")
+		RespondError(w, http.StatusInternalServerError, "Failed to deduplicate trackers")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, removed)
+}
+
+// decodeTorrentHashes reads the instance ID and a non-empty TorrentHashesRequest, responding with
+// an error and returning false when either is invalid
+func decodeTorrentHashes(w http.ResponseWriter, r *http.Request) (int, []string, bool) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return 0, nil, false
+	}
+
+	var req TorrentHashesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid request body")
+		return 0, nil, false
+	}
+
+	if len(req.Hashes) == 0 {
+		RespondError(w, http.StatusBadRequest, "At least one hash is required")
+		return 0, nil, false
+	}
+
+	return instanceID, req.Hashes, true
+}
+
+// GetCategories returns all categories
+func (h *TorrentsHandler) GetCategories(w http.ResponseWriter, r *http.Request) {
+	// Get instance ID from URL
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return
+	}
+
+	// Get categories
+	categories, err := h.syncManager.GetCategories(r.Context(), instanceID)
+	if err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to get categories")
+		RespondError(w, http.StatusInternalServerError, "Failed to get categories")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, categories)
+}
+
+// CreateCategory creates a new category
+func (h *TorrentsHandler) CreateCategory(w http.ResponseWriter, r *http.Request) {
+	// Get instance ID from URL
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return
+	}
+
+	var req struct {
+		Name     string `json:"name"`
+		SavePath string `json:"savePath"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if req.Name == "" {
+		RespondError(w, http.StatusBadRequest, "Category name is required")
+		return
+	}
+
+	if err := h.syncManager.CreateCategory(r.Context(), instanceID, req.Name, req.SavePath); err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to create category")
+		RespondError(w, http.StatusInternalServerError, "Failed to create category")
+		return
+	}
+
+	RespondJSON(w, http.StatusCreated, map[string]string{
+		"message": "Category created successfully",
+	})
+}
+
+// EditCategory edits an existing category
+func (h *TorrentsHandler) EditCategory(w http.ResponseWriter, r *http.Request) {
+	// Get instance ID from URL
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return
+	}
+
+	var req struct {
+		Name     string `json:"name"`
+		SavePath string `json:"savePath"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if req.Name == "" {
+		RespondError(w, http.StatusBadRequest, "Category name is required")
+		return
+	}
+
+	if err := h.syncManager.EditCategory(r.Context(), instanceID, req.Name, req.SavePath); err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to edit category")
+		RespondError(w, http.StatusInternalServerError, "Failed to edit category")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, map[string]string{
+		"message": "Category updated successfully",
+	})
+}
+
+// RemoveCategories removes categories
+func (h *TorrentsHandler) RemoveCategories(w http.ResponseWriter, r *http.Request) {
+	// Get instance ID from URL
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return
+	}
+
+	var req struct {
+		Categories []string `json:"categories"`
+	}
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		RespondError(w, http.StatusBadRequest, "Invalid request body")
 		return
@@ -668,6 +1318,43 @@ func (h *TorrentsHandler) RemoveCategories(w http.ResponseWriter, r *http.Reques
 	})
 }
 
+// ApplyCategoryTemplateRequest represents a request to converge instance categories to a template
+type ApplyCategoryTemplateRequest struct {
+	InstanceIDs  []int                     `json:"instanceIds"`
+	Categories   []qbittorrent.CategoryDef `json:"categories"`
+	RemoveExtras bool                      `json:"removeExtras"`
+}
+
+// ApplyCategoryTemplate creates and updates categories on several instances to match a template
+func (h *TorrentsHandler) ApplyCategoryTemplate(w http.ResponseWriter, r *http.Request) {
+	var req ApplyCategoryTemplateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if len(req.InstanceIDs) == 0 {
+		RespondError(w, http.StatusBadRequest, "No instances provided")
+		return
+	}
+
+	for _, category := range req.Categories {
+		if strings.TrimSpace(category.Name) == "" {
+			RespondError(w, http.StatusBadRequest, "Category name is required")
+			return
+		}
+	}
+
+	diffs, err := h.syncManager.ApplyCategoryTemplate(r.Context(), req.InstanceIDs, req.Categories, req.RemoveExtras)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to apply category template")
+		RespondError(w, http.StatusInternalServerError, "Failed to apply category template")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, diffs)
+}
+
 // GetTags returns all tags
 func (h *TorrentsHandler) GetTags(w http.ResponseWriter, r *http.Request) {
 	// Get instance ID from URL
@@ -688,6 +1375,24 @@ func (h *TorrentsHandler) GetTags(w http.ResponseWriter, r *http.Request) {
 	RespondJSON(w, http.StatusOK, tags)
 }
 
+// GetTagSuggestions returns existing tags matching a prefix for autocomplete
+func (h *TorrentsHandler) GetTagSuggestions(w http.ResponseWriter, r *http.Request) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return
+	}
+
+	suggestions, err := h.syncManager.GetTagSuggestions(r.Context(), instanceID, r.URL.Query().Get("prefix"))
+	if err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to get tag suggestions")
+		RespondError(w, http.StatusInternalServerError, "Failed to get tag suggestions")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, suggestions)
+}
+
 // CreateTags creates new tags
 func (h *TorrentsHandler) CreateTags(w http.ResponseWriter, r *http.Request) {
 	// Get instance ID from URL
@@ -754,6 +1459,83 @@ func (h *TorrentsHandler) DeleteTags(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// GetUnusedLabels returns the tags and categories no torrent uses
+func (h *TorrentsHandler) GetUnusedLabels(w http.ResponseWriter, r *http.Request) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return
+	}
+
+	labels, err := h.syncManager.PruneUnused(r.Context(), instanceID, true)
+	if err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to get unused tags and categories")
+		RespondError(w, http.StatusInternalServerError, "Failed to get unused tags and categories")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, labels)
+}
+
+// PruneUnusedLabels deletes the tags and categories no torrent uses. It only lists them unless
+// dryRun=false is passed.
+func (h *TorrentsHandler) PruneUnusedLabels(w http.ResponseWriter, r *http.Request) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return
+	}
+
+	dryRun := r.URL.Query().Get("dryRun") != "false"
+
+	labels, err := h.syncManager.PruneUnused(r.Context(), instanceID, dryRun)
+	if err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Bool("dryRun", dryRun).Msg("Failed to prune unused tags and categories")
+		RespondError(w, http.StatusInternalServerError, "Failed to prune unused tags and categories")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, labels)
+}
+
+// GetTrackerExclusions returns the torrents temporarily hidden from tracker counts after tracker edits
+func (h *TorrentsHandler) GetTrackerExclusions(w http.ResponseWriter, r *http.Request) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return
+	}
+
+	exclusions, err := h.syncManager.GetTrackerExclusions(r.Context(), instanceID)
+	if err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to get tracker exclusions")
+		RespondError(w, http.StatusInternalServerError, "Failed to get tracker exclusions")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, exclusions)
+}
+
+// ClearTrackerExclusions clears tracker exclusions for the domains in the query, or all domains
+func (h *TorrentsHandler) ClearTrackerExclusions(w http.ResponseWriter, r *http.Request) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return
+	}
+
+	domains := r.URL.Query()["domain"]
+	if err := h.syncManager.ClearTrackerExclusions(r.Context(), instanceID, domains); err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to clear tracker exclusions")
+		RespondError(w, http.StatusInternalServerError, "Failed to clear tracker exclusions")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, map[string]string{
+		"message": "Tracker exclusions cleared successfully",
+	})
+}
+
 // GetTorrentProperties returns detailed properties for a specific torrent
 func (h *TorrentsHandler) GetTorrentProperties(w http.ResponseWriter, r *http.Request) {
 	// Get instance ID and hash from URL
@@ -933,6 +1715,68 @@ func (h *TorrentsHandler) RemoveTorrentTrackers(w http.ResponseWriter, r *http.R
 	RespondJSON(w, http.StatusOK, map[string]string{"status": "success"})
 }
 
+// TrackerTiersRequest represents the trackers of a torrent grouped by tier
+type TrackerTiersRequest struct {
+	Tiers [][]string `json:"tiers"` // Tier 0 first
+}
+
+// GetTrackerTiers returns the trackers of a torrent grouped by tier
+func (h *TorrentsHandler) GetTrackerTiers(w http.ResponseWriter, r *http.Request) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return
+	}
+
+	hash := chi.URLParam(r, "hash")
+	if hash == "" {
+		RespondError(w, http.StatusBadRequest, "Torrent hash is required")
+		return
+	}
+
+	tiers, err := h.syncManager.GetTrackerTiers(r.Context(), instanceID, hash)
+	if err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Str("hash", hash).Msg("Failed to get tracker tiers")
+		RespondError(w, http.StatusInternalServerError, "Failed to get tracker tiers")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, TrackerTiersRequest{Tiers: tiers})
+}
+
+// SetTrackerTiers reorganizes the trackers of a torrent into tiers
+func (h *TorrentsHandler) SetTrackerTiers(w http.ResponseWriter, r *http.Request) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return
+	}
+
+	hash := chi.URLParam(r, "hash")
+	if hash == "" {
+		RespondError(w, http.StatusBadRequest, "Torrent hash is required")
+		return
+	}
+
+	var req TrackerTiersRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if err := h.syncManager.SetTrackerTiers(r.Context(), instanceID, hash, req.Tiers); err != nil {
+		if errors.Is(err, qbittorrent.ErrInvalidTrackerTiers) || errors.Is(err, qbittorrent.ErrTrackerTiersUnsupported) {
+			RespondError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		log.Error().Err(err).Int("instanceID", instanceID).Str("hash", hash).Msg("Failed to set tracker tiers")
+		RespondError(w, http.StatusInternalServerError, "Failed to set tracker tiers")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, map[string]string{"status": "success"})
+}
+
 // GetTorrentFiles returns files information for a specific torrent
 func (h *TorrentsHandler) GetTorrentPeers(w http.ResponseWriter, r *http.Request) {
 	// Get instance ID and hash from URL
@@ -1035,6 +1879,214 @@ func (h *TorrentsHandler) GetTorrentFiles(w http.ResponseWriter, r *http.Request
 	RespondJSON(w, http.StatusOK, files)
 }
 
+// GetTorrentDuplicates returns other torrents on the instance that hold the same content
+func (h *TorrentsHandler) GetTorrentDuplicates(w http.ResponseWriter, r *http.Request) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return
+	}
+
+	hash := chi.URLParam(r, "hash")
+	if hash == "" {
+		RespondError(w, http.StatusBadRequest, "Torrent hash is required")
+		return
+	}
+
+	duplicates, err := h.syncManager.FindDuplicatesForHash(r.Context(), instanceID, hash)
+	if err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Str("hash", hash).Msg("Failed to find duplicate torrents")
+		RespondError(w, http.StatusInternalServerError, "Failed to find duplicate torrents")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, duplicates)
+}
+
+// SetFilePrioritiesRequest maps file indices to download priorities (0 skip, 1 normal, 6 high, 7 maximum)
+type SetFilePrioritiesRequest struct {
+	Priorities map[int]int `json:"priorities"`
+}
+
+// SetFilePriorities sets the download priority of individual files
+func (h *TorrentsHandler) SetFilePriorities(w http.ResponseWriter, r *http.Request) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return
+	}
+
+	hash := chi.URLParam(r, "hash")
+	if hash == "" {
+		RespondError(w, http.StatusBadRequest, "Torrent hash is required")
+		return
+	}
+
+	var req SetFilePrioritiesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if err := h.syncManager.SetFilePriorities(r.Context(), instanceID, hash, req.Priorities); err != nil {
+		if errors.Is(err, qbittorrent.ErrInvalidFilePriority) {
+			RespondError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		log.Error().Err(err).Int("instanceID", instanceID).Str("hash", hash).Msg("Failed to set file priorities")
+		RespondError(w, http.StatusInternalServerError, "Failed to set file priorities")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, map[string]string{
+		"message": "File priorities updated successfully",
+	})
+}
+
+// SkipFilesRequest represents a request to skip files matching a glob pattern
+type SkipFilesRequest struct {
+	Pattern string `json:"pattern"`
+}
+
+// SkipFiles sets files matching a glob pattern to not be downloaded
+func (h *TorrentsHandler) SkipFiles(w http.ResponseWriter, r *http.Request) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return
+	}
+
+	hash := chi.URLParam(r, "hash")
+	if hash == "" {
+		RespondError(w, http.StatusBadRequest, "Torrent hash is required")
+		return
+	}
+
+	var req SkipFilesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if _, err := path.Match(strings.ToLower(req.Pattern), ""); err != nil || strings.TrimSpace(req.Pattern) == "" {
+		RespondError(w, http.StatusBadRequest, "A valid glob pattern is required")
+		return
+	}
+
+	skipped, err := h.syncManager.SkipFilesMatching(r.Context(), instanceID, hash, req.Pattern)
+	if err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Str("hash", hash).Msg("Failed to skip files")
+		RespondError(w, http.StatusInternalServerError, "Failed to skip files")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, map[string]int{
+		"skipped": skipped,
+	})
+}
+
+// SetQueuePositionRequest represents a request to move a torrent to an absolute queue position
+type SetQueuePositionRequest struct {
+	Position int `json:"position"`
+}
+
+// SetQueuePosition moves a torrent to an absolute queue position. The resulting position is
+// returned so the client can reorder its table before the next sync arrives.
+func (h *TorrentsHandler) SetQueuePosition(w http.ResponseWriter, r *http.Request) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return
+	}
+
+	hash := chi.URLParam(r, "hash")
+	if hash == "" {
+		RespondError(w, http.StatusBadRequest, "Torrent hash is required")
+		return
+	}
+
+	var req SetQueuePositionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if req.Position < 1 {
+		RespondError(w, http.StatusBadRequest, "Queue position must be at least 1")
+		return
+	}
+
+	position, err := h.syncManager.SetQueuePosition(r.Context(), instanceID, hash, req.Position)
+	if err != nil {
+		if errors.Is(err, qbittorrent.ErrTorrentNotFound) {
+			RespondError(w, http.StatusNotFound, "Torrent not found")
+			return
+		}
+		if errors.Is(err, qbittorrent.ErrTorrentNotQueued) {
+			RespondError(w, http.StatusConflict, "Torrent is not queued")
+			return
+		}
+		log.Error().Err(err).Int("instanceID", instanceID).Str("hash", hash).Msg("Failed to set queue position")
+		RespondError(w, http.StatusInternalServerError, "Failed to set queue position")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, map[string]int{
+		"position": position,
+	})
+}
+
+// MigrateTorrentRequest represents a request to move a torrent to another instance
+type MigrateTorrentRequest struct {
+	DestinationInstanceID int  `json:"destinationInstanceId"`
+	DeleteFromSource      bool `json:"deleteFromSource"`
+}
+
+// MigrateTorrent moves a torrent to another instance
+func (h *TorrentsHandler) MigrateTorrent(w http.ResponseWriter, r *http.Request) {
+	instanceID, err := strconv.Atoi(chi.URLParam(r, "instanceID"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid instance ID")
+		return
+	}
+
+	hash := chi.URLParam(r, "hash")
+	if hash == "" {
+		RespondError(w, http.StatusBadRequest, "Torrent hash is required")
+		return
+	}
+
+	var req MigrateTorrentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if req.DestinationInstanceID <= 0 || req.DestinationInstanceID == instanceID {
+		RespondError(w, http.StatusBadRequest, "A different destination instance is required")
+		return
+	}
+
+	result, err := h.syncManager.MigrateTorrent(r.Context(), instanceID, req.DestinationInstanceID, hash, req.DeleteFromSource)
+	if err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Int("destinationInstanceID", req.DestinationInstanceID).Str("hash", hash).Msg("Failed to migrate torrent")
+
+		var migrateErr *qbittorrent.MigrateError
+		if errors.As(err, &migrateErr) {
+			RespondJSON(w, http.StatusInternalServerError, map[string]string{
+				"error":        "Failed to migrate torrent",
+				"rollbackNote": migrateErr.RollbackNote,
+			})
+			return
+		}
+
+		RespondError(w, http.StatusInternalServerError, "Failed to migrate torrent")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, result)
+}
+
 // AddPeers adds peers to torrents
 func (h *TorrentsHandler) AddPeers(w http.ResponseWriter, r *http.Request) {
 	// Get instance ID from URL
@@ -1046,8 +2098,9 @@ func (h *TorrentsHandler) AddPeers(w http.ResponseWriter, r *http.Request) {
 
 	// Parse request body
 	var req struct {
-		Hashes []string `json:"hashes"`
-		Peers  []string `json:"peers"`
+		Hashes       []string `json:"hashes"`
+		Peers        []string `json:"peers"`
+		AllowPrivate bool     `json:"allowPrivate"`
 	}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -1061,7 +2114,12 @@ func (h *TorrentsHandler) AddPeers(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Add peers
-	err = h.syncManager.AddPeersToTorrents(r.Context(), instanceID, req.Hashes, req.Peers)
+	err = h.syncManager.AddPeersToTorrents(r.Context(), instanceID, req.Hashes, req.Peers, req.AllowPrivate)
+	var privateErr *qbittorrent.PrivateTorrentsError
+	if errors.As(err, &privateErr) {
+		respondPrivateTorrents(w, privateErr)
+		return
+	}
 	if err != nil {
 		log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to add peers to torrents")
 		RespondError(w, http.StatusInternalServerError, "Failed to add peers")
@@ -1071,6 +2129,14 @@ func (h *TorrentsHandler) AddPeers(w http.ResponseWriter, r *http.Request) {
 	RespondJSON(w, http.StatusOK, map[string]bool{"success": true})
 }
 
+// respondPrivateTorrents reports an action refused to protect private torrents
+func respondPrivateTorrents(w http.ResponseWriter, err *qbittorrent.PrivateTorrentsError) {
+	RespondJSON(w, http.StatusConflict, map[string]any{
+		"error":         fmt.Sprintf("%s is not allowed on private torrents; set allowPrivate to override", err.Action),
+		"privateHashes": err.Hashes,
+	})
+}
+
 // BanPeers bans peers permanently
 func (h *TorrentsHandler) BanPeers(w http.ResponseWriter, r *http.Request) {
 	// Get instance ID from URL
@@ -0,0 +1,84 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/alexedwards/scs/v2"
+	"github.com/rs/zerolog/log"
+
+	"github.com/autobrr/qui/internal/models"
+)
+
+// UserPreferencesHandler stores UI preferences for the logged-in user
+type UserPreferencesHandler struct {
+	store          *models.UserPreferencesStore
+	sessionManager *scs.SessionManager
+}
+
+func NewUserPreferencesHandler(store *models.UserPreferencesStore, sessionManager *scs.SessionManager) *UserPreferencesHandler {
+	return &UserPreferencesHandler{
+		store:          store,
+		sessionManager: sessionManager,
+	}
+}
+
+// sessionUserID returns the user of the session. API key requests have no user.
+func (h *UserPreferencesHandler) sessionUserID(w http.ResponseWriter, r *http.Request) (int, bool) {
+	userID := h.sessionManager.GetInt(r.Context(), "user_id")
+	if userID == 0 {
+		RespondError(w, http.StatusUnauthorized, "Preferences require a user session")
+		return 0, false
+	}
+	return userID, true
+}
+
+// GetUserPreferences returns the preferences of the logged-in user
+func (h *UserPreferencesHandler) GetUserPreferences(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.sessionUserID(w, r)
+	if !ok {
+		return
+	}
+
+	preferences, err := h.store.Get(r.Context(), userID)
+	if err != nil {
+		log.Error().Err(err).Int("userID", userID).Msg("Failed to get user preferences")
+		RespondError(w, http.StatusInternalServerError, "Failed to get preferences")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, preferences)
+}
+
+// UpdateUserPreferences replaces the preferences of the logged-in user
+func (h *UserPreferencesHandler) UpdateUserPreferences(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.sessionUserID(w, r)
+	if !ok {
+		return
+	}
+
+	// Read one byte past the cap so oversized bodies fail validation instead of being truncated
+	body, err := io.ReadAll(io.LimitReader(r.Body, models.MaxUserPreferencesSize+1))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	preferences := json.RawMessage(body)
+	if err := h.store.Update(r.Context(), userID, preferences); err != nil {
+		if errors.Is(err, models.ErrInvalidPreferences) {
+			RespondError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		log.Error().Err(err).Int("userID", userID).Msg("Failed to update user preferences")
+		RespondError(w, http.StatusInternalServerError, "Failed to update preferences")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, preferences)
+}
@@ -37,14 +37,25 @@ type Server struct {
 	config  *config.AppConfig
 	version string
 
-	authService       *auth.Service
-	sessionManager    *scs.SessionManager
-	instanceStore     *models.InstanceStore
-	clientAPIKeyStore *models.ClientAPIKeyStore
-	clientPool        *qbittorrent.ClientPool
-	syncManager       *qbittorrent.SyncManager
-	licenseService    *license.Service
-	updateService     *update.Service
+	authService         *auth.Service
+	sessionManager      *scs.SessionManager
+	instanceStore       *models.InstanceStore
+	clientAPIKeyStore   *models.ClientAPIKeyStore
+	searchSettingsStore *models.SearchSettingsStore
+	duplicateSettings   *models.DuplicateSettingsStore
+	userPreferences     *models.UserPreferencesStore
+	refreshSettings     *models.RefreshSettingsStore
+	clientPool          *qbittorrent.ClientPool
+	syncManager         *qbittorrent.SyncManager
+	licenseService      *license.Service
+	licenseScheduler    *license.RefreshScheduler
+	actionScheduler     *qbittorrent.ActionScheduler
+	diskSpaceSettings   *models.DiskSpaceSettingsStore
+	diskSpaceGuard      *qbittorrent.DiskSpaceGuard
+	completedCategories *models.CompletedCategorySettingsStore
+	timelineCollector   *qbittorrent.TimelineCollector
+	backupScheduler     *qbittorrent.TorrentBackupScheduler
+	updateService       *update.Service
 }
 
 func NewServer(deps *Dependencies) *Server {
@@ -55,17 +66,28 @@ func NewServer(deps *Dependencies) *Server {
 			WriteTimeout:      120 * time.Second,
 			IdleTimeout:       180 * time.Second,
 		},
-		logger:            log.Logger.With().Str("module", "api").Logger(),
-		config:            deps.Config,
-		version:           deps.Version,
-		authService:       deps.AuthService,
-		sessionManager:    deps.SessionManager,
-		instanceStore:     deps.InstanceStore,
-		clientAPIKeyStore: deps.ClientAPIKeyStore,
-		clientPool:        deps.ClientPool,
-		syncManager:       deps.SyncManager,
-		licenseService:    deps.LicenseService,
-		updateService:     deps.UpdateService,
+		logger:              log.Logger.With().Str("module", "api").Logger(),
+		config:              deps.Config,
+		version:             deps.Version,
+		authService:         deps.AuthService,
+		sessionManager:      deps.SessionManager,
+		instanceStore:       deps.InstanceStore,
+		clientAPIKeyStore:   deps.ClientAPIKeyStore,
+		searchSettingsStore: deps.SearchSettingsStore,
+		duplicateSettings:   deps.DuplicateSettingsStore,
+		userPreferences:     deps.UserPreferencesStore,
+		refreshSettings:     deps.RefreshSettingsStore,
+		clientPool:          deps.ClientPool,
+		syncManager:         deps.SyncManager,
+		licenseService:      deps.LicenseService,
+		licenseScheduler:    deps.LicenseScheduler,
+		actionScheduler:     deps.ActionScheduler,
+		diskSpaceSettings:   deps.DiskSpaceSettingsStore,
+		diskSpaceGuard:      deps.DiskSpaceGuard,
+		completedCategories: deps.CompletedCategoryStore,
+		timelineCollector:   deps.TimelineCollector,
+		backupScheduler:     deps.BackupScheduler,
+		updateService:       deps.UpdateService,
 	}
 
 	// Create HTTP server with configurable timeouts
@@ -161,8 +183,19 @@ func (s *Server) Handler() *chi.Mux {
 	healthHandler := handlers.NewHealthHandler()
 	authHandler := handlers.NewAuthHandler(s.authService, s.sessionManager, s.instanceStore, s.clientPool, s.syncManager)
 	instancesHandler := handlers.NewInstancesHandler(s.instanceStore, s.clientPool, s.syncManager)
+	searchSettingsHandler := handlers.NewSearchSettingsHandler(s.searchSettingsStore, s.syncManager)
+	duplicateSettingsHandler := handlers.NewDuplicateSettingsHandler(s.duplicateSettings, s.syncManager)
+	refreshSettingsHandler := handlers.NewRefreshSettingsHandler(s.refreshSettings, s.syncManager)
 	torrentsHandler := handlers.NewTorrentsHandler(s.syncManager)
+	scheduledActionsHandler := handlers.NewScheduledActionsHandler(s.actionScheduler)
+	diskSpaceGuardHandler := handlers.NewDiskSpaceGuardHandler(s.diskSpaceSettings, s.diskSpaceGuard)
+	completedCategoryHandler := handlers.NewCompletedCategoryHandler(s.completedCategories)
+	sidebarMetadataHandler := handlers.NewSidebarMetadataHandler(s.syncManager)
+	timelineHandler := handlers.NewTimelineHandler(s.timelineCollector)
+	backupHandler := handlers.NewBackupHandler(s.backupScheduler)
 	preferencesHandler := handlers.NewPreferencesHandler(s.syncManager)
+	userPreferencesHandler := handlers.NewUserPreferencesHandler(s.userPreferences, s.sessionManager)
+	searchHandler := handlers.NewSearchHandler(s.syncManager)
 	clientAPIKeysHandler := handlers.NewClientAPIKeysHandler(s.clientAPIKeyStore, s.instanceStore)
 	versionHandler := handlers.NewVersionHandler(s.updateService)
 
@@ -172,7 +205,7 @@ func (s *Server) Handler() *chi.Mux {
 	// license handler (optional, only if the license service is configured)
 	var licenseHandler *handlers.LicenseHandler
 	if s.licenseService != nil {
-		licenseHandler = handlers.NewLicenseHandler(s.licenseService)
+		licenseHandler = handlers.NewLicenseHandler(s.licenseService, s.licenseScheduler)
 	}
 
 	// API routes
@@ -222,36 +255,127 @@ func (s *Server) Handler() *chi.Mux {
 				r.Delete("/{id}", clientAPIKeysHandler.DeleteClientAPIKey)
 			})
 
+			// Per-instance health for monitoring
+			r.Get("/health", instancesHandler.GetHealth)
+
 			// Version endpoint for update checks
 			r.Get("/version/latest", versionHandler.GetLatestVersion)
 
+			// Search ranking settings
+			r.Route("/settings/search", func(r chi.Router) {
+				r.Get("/", searchSettingsHandler.GetSearchWeights)
+				r.Put("/", searchSettingsHandler.UpdateSearchWeights)
+			})
+
+			// UI refresh interval
+			r.Route("/settings/refresh", func(r chi.Router) {
+				r.Get("/", refreshSettingsHandler.GetRefreshSettings)
+				r.Put("/", refreshSettingsHandler.UpdateRefreshSettings)
+			})
+
+			// Per-user UI preferences
+			r.Get("/preferences", userPreferencesHandler.GetUserPreferences)
+			r.Put("/preferences", userPreferencesHandler.UpdateUserPreferences)
+
+			// Cross-instance torrent search
+			r.Get("/torrents/search", torrentsHandler.SearchAllInstances)
+
+			// Cross-instance dashboard totals
+			r.Get("/dashboard/stats", torrentsHandler.GetDashboardStats)
+
+			// Cross-instance category template
+			r.Post("/categories/template", torrentsHandler.ApplyCategoryTemplate)
+
+			// Background bulk operations
+			r.Get("/jobs/{id}", torrentsHandler.GetJob)
+			r.Delete("/jobs/{id}", torrentsHandler.CancelJob)
+
 			// Instance management
 			r.Route("/instances", func(r chi.Router) {
 				r.Get("/", instancesHandler.ListInstances)
 				r.Post("/", instancesHandler.CreateInstance)
+				r.Post("/import", instancesHandler.ImportInstance)
 
 				r.Route("/{instanceID}", func(r chi.Router) {
 					r.Put("/", instancesHandler.UpdateInstance)
 					r.Delete("/", instancesHandler.DeleteInstance)
 					r.Post("/test", instancesHandler.TestConnection)
+					r.Post("/resync", instancesHandler.ResyncInstance)
+					r.Get("/sync-health", instancesHandler.GetSyncHealth)
+					r.Get("/export", instancesHandler.ExportInstance)
+					r.Get("/version", instancesHandler.GetInstanceVersion)
+					r.Get("/capabilities", instancesHandler.GetCapabilities)
+					r.Post("/backup", backupHandler.BackupNow)
+					r.Get("/duplicate-settings", duplicateSettingsHandler.GetDuplicateSettings)
+					r.Put("/duplicate-settings", duplicateSettingsHandler.UpdateDuplicateSettings)
+					r.Post("/duplicate-settings/preview", duplicateSettingsHandler.PreviewNormalizedName)
+					r.Get("/disk-space-guard", diskSpaceGuardHandler.GetDiskSpaceGuard)
+					r.Put("/disk-space-guard", diskSpaceGuardHandler.UpdateDiskSpaceGuard)
+					r.Post("/disk-space-guard/resume", diskSpaceGuardHandler.ResumeDiskSpaceGuard)
+					r.Get("/completed-category", completedCategoryHandler.GetCompletedCategorySettings)
+					r.Put("/completed-category", completedCategoryHandler.UpdateCompletedCategorySettings)
+					r.Get("/sidebar-metadata", sidebarMetadataHandler.GetSidebarMetadata)
+					r.Put("/sidebar-metadata", sidebarMetadataHandler.SetSidebarMetadata)
+					r.Delete("/sidebar-metadata", sidebarMetadataHandler.DeleteSidebarMetadata)
 
 					// Torrent operations
 					r.Route("/torrents", func(r chi.Router) {
-						r.Get("/", torrentsHandler.ListTorrents)
-						r.Post("/", torrentsHandler.AddTorrent)
-						r.Post("/bulk-action", torrentsHandler.BulkAction)
-						r.Post("/add-peers", torrentsHandler.AddPeers)
-						r.Post("/ban-peers", torrentsHandler.BanPeers)
-
-						r.Route("/{hash}", func(r chi.Router) {
-							// Torrent details
-							r.Get("/properties", torrentsHandler.GetTorrentProperties)
-							r.Get("/trackers", torrentsHandler.GetTorrentTrackers)
-							r.Put("/trackers", torrentsHandler.EditTorrentTracker)
-							r.Post("/trackers", torrentsHandler.AddTorrentTrackers)
-							r.Delete("/trackers", torrentsHandler.RemoveTorrentTrackers)
-							r.Get("/peers", torrentsHandler.GetTorrentPeers)
-							r.Get("/files", torrentsHandler.GetTorrentFiles)
+						// Schedules only touch the database, so they don't wait for the instance to be ready
+						r.Get("/scheduled-actions", scheduledActionsHandler.ListScheduledActions)
+						r.Post("/{hash}/scheduled-actions/{action}", scheduledActionsHandler.ScheduleAction)
+						r.Delete("/{hash}/scheduled-actions/{action}", scheduledActionsHandler.CancelScheduledAction)
+						r.Get("/scheduled-resumes", scheduledActionsHandler.ListScheduledResumes)
+						r.Post("/{hash}/scheduled-resume", scheduledActionsHandler.ScheduleResume)
+						r.Delete("/{hash}/scheduled-resume", scheduledActionsHandler.CancelScheduledResume)
+
+						r.Group(func(r chi.Router) {
+							r.Use(torrentsHandler.RequireInstanceReady)
+
+							r.Get("/", torrentsHandler.ListTorrents)
+							r.Post("/", torrentsHandler.AddTorrent)
+							r.Post("/bulk-action", torrentsHandler.BulkAction)
+							r.Post("/by-filter/add-tags", torrentsHandler.AddTagsByFilter)
+							r.Post("/by-filter/remove-tags", torrentsHandler.RemoveTagsByFilter)
+							r.Post("/by-filter/set-category", torrentsHandler.SetCategoryByFilter)
+							r.Post("/pause-all", torrentsHandler.PauseAll)
+							r.Post("/resume-all", torrentsHandler.ResumeAll)
+							r.Post("/recover-errored", torrentsHandler.RecoverErrored)
+							r.Post("/recheck-resume", torrentsHandler.RecheckAndResume)
+							r.Get("/recheck-queue", torrentsHandler.GetRecheckQueue)
+							r.Post("/recheck-queue", torrentsHandler.StartRecheckQueue)
+							r.Delete("/recheck-queue", torrentsHandler.CancelRecheckQueue)
+							r.Post("/set-location-recheck", torrentsHandler.SetLocationAndRecheck)
+							r.Post("/export/magnets", torrentsHandler.ExportMagnets)
+							r.Post("/export/hashes", torrentsHandler.ExportHashes)
+							r.Post("/add-peers", torrentsHandler.AddPeers)
+							r.Post("/ban-peers", torrentsHandler.BanPeers)
+							r.Post("/duplicate-trackers", torrentsHandler.FindDuplicateTrackers)
+							r.Post("/deduplicate-trackers", torrentsHandler.DeduplicateTrackers)
+							r.Post("/limits", torrentsHandler.GetTorrentLimits)
+							r.Get("/top", torrentsHandler.GetTopTorrents)
+							r.Get("/recently-completed", torrentsHandler.GetRecentlyCompleted)
+							r.Get("/seeding-goals", torrentsHandler.GetSeedingGoals)
+							r.Get("/changes", torrentsHandler.GetTorrentChanges)
+							r.Get("/tracked-paths", torrentsHandler.GetTrackedPaths)
+
+							r.Route("/{hash}", func(r chi.Router) {
+								// Torrent details
+								r.Get("/properties", torrentsHandler.GetTorrentProperties)
+								r.Get("/trackers", torrentsHandler.GetTorrentTrackers)
+								r.Put("/trackers", torrentsHandler.EditTorrentTracker)
+								r.Post("/trackers", torrentsHandler.AddTorrentTrackers)
+								r.Delete("/trackers", torrentsHandler.RemoveTorrentTrackers)
+								r.Get("/tracker-tiers", torrentsHandler.GetTrackerTiers)
+								r.Put("/tracker-tiers", torrentsHandler.SetTrackerTiers)
+								r.Get("/peers", torrentsHandler.GetTorrentPeers)
+								r.Get("/files", torrentsHandler.GetTorrentFiles)
+								r.Put("/files/priority", torrentsHandler.SetFilePriorities)
+								r.Post("/files/skip", torrentsHandler.SkipFiles)
+								r.Post("/queue-position", torrentsHandler.SetQueuePosition)
+								r.Get("/timeline", timelineHandler.GetTorrentTimeline)
+								r.Get("/duplicates", torrentsHandler.GetTorrentDuplicates)
+								r.Post("/migrate", torrentsHandler.MigrateTorrent)
+							})
 						})
 					})
 
@@ -264,6 +388,34 @@ func (s *Server) Handler() *chi.Mux {
 					r.Get("/tags", torrentsHandler.GetTags)
 					r.Post("/tags", torrentsHandler.CreateTags)
 					r.Delete("/tags", torrentsHandler.DeleteTags)
+					r.Get("/tags/suggestions", torrentsHandler.GetTagSuggestions)
+
+					// Unused tags and categories
+					r.Get("/unused-labels", torrentsHandler.GetUnusedLabels)
+					r.Post("/unused-labels/prune", torrentsHandler.PruneUnusedLabels)
+
+					// Transient tracker count exclusions after tracker edits
+					r.Get("/tracker-exclusions", torrentsHandler.GetTrackerExclusions)
+					r.Delete("/tracker-exclusions", torrentsHandler.ClearTrackerExclusions)
+
+					// Torrent creator
+					r.Route("/torrent-creator", func(r chi.Router) {
+						r.Get("/", torrentsHandler.ListTorrentCreationTasks)
+						r.Post("/", torrentsHandler.CreateTorrent)
+						r.Get("/{taskID}", torrentsHandler.GetTorrentCreationTask)
+						r.Get("/{taskID}/file", torrentsHandler.DownloadCreatedTorrent)
+						r.Delete("/{taskID}", torrentsHandler.DeleteTorrentCreationTask)
+					})
+
+					// Search engine (qBittorrent search plugins)
+					r.Route("/search", func(r chi.Router) {
+						r.Get("/", searchHandler.ListSearches)
+						r.Post("/", searchHandler.StartSearch)
+						r.Get("/plugins", searchHandler.GetPlugins)
+						r.Post("/add", searchHandler.AddResult)
+						r.Get("/{searchID}", searchHandler.GetSearchResults)
+						r.Delete("/{searchID}", searchHandler.StopSearch)
+					})
 
 					// Preferences
 					r.Get("/preferences", preferencesHandler.GetPreferences)
@@ -272,6 +424,16 @@ func (s *Server) Handler() *chi.Mux {
 					// Alternative speed limits
 					r.Get("/alternative-speed-limits", preferencesHandler.GetAlternativeSpeedLimitsMode)
 					r.Post("/alternative-speed-limits/toggle", preferencesHandler.ToggleAlternativeSpeedLimits)
+
+					// Queue limits
+					r.Get("/queue-limits", preferencesHandler.GetQueueLimits)
+					r.Put("/queue-limits", preferencesHandler.SetQueueLimits)
+
+					// Speed limits
+					r.Get("/speed-limits", preferencesHandler.GetGlobalSpeedLimits)
+					r.Put("/speed-limits", preferencesHandler.SetGlobalSpeedLimits)
+					r.Get("/speed-limits/alternative", preferencesHandler.GetAlternativeSpeedLimits)
+					r.Put("/speed-limits/alternative", preferencesHandler.SetAlternativeSpeedLimits)
 				})
 			})
 
@@ -331,15 +493,26 @@ func (s *Server) Handler() *chi.Mux {
 
 // Dependencies holds all the dependencies needed for the API
 type Dependencies struct {
-	Config            *config.AppConfig
-	Version           string
-	AuthService       *auth.Service
-	SessionManager    *scs.SessionManager
-	InstanceStore     *models.InstanceStore
-	ClientAPIKeyStore *models.ClientAPIKeyStore
-	ClientPool        *qbittorrent.ClientPool
-	SyncManager       *qbittorrent.SyncManager
-	WebHandler        *web.Handler
-	LicenseService    *license.Service
-	UpdateService     *update.Service
+	Config                 *config.AppConfig
+	Version                string
+	AuthService            *auth.Service
+	SessionManager         *scs.SessionManager
+	InstanceStore          *models.InstanceStore
+	ClientAPIKeyStore      *models.ClientAPIKeyStore
+	SearchSettingsStore    *models.SearchSettingsStore
+	DuplicateSettingsStore *models.DuplicateSettingsStore
+	UserPreferencesStore   *models.UserPreferencesStore
+	RefreshSettingsStore   *models.RefreshSettingsStore
+	ClientPool             *qbittorrent.ClientPool
+	SyncManager            *qbittorrent.SyncManager
+	WebHandler             *web.Handler
+	LicenseService         *license.Service
+	LicenseScheduler       *license.RefreshScheduler
+	ActionScheduler        *qbittorrent.ActionScheduler
+	DiskSpaceSettingsStore *models.DiskSpaceSettingsStore
+	DiskSpaceGuard         *qbittorrent.DiskSpaceGuard
+	CompletedCategoryStore *models.CompletedCategorySettingsStore
+	TimelineCollector      *qbittorrent.TimelineCollector
+	BackupScheduler        *qbittorrent.TorrentBackupScheduler
+	UpdateService          *update.Service
 }
@@ -158,6 +158,8 @@ func normalizeRoutePath(path string) (string, bool) {
 	}
 
 	path = strings.ReplaceAll(path, "{instanceID}", "{instanceId}")
+	path = strings.ReplaceAll(path, "{searchID}", "{searchId}")
+	path = strings.ReplaceAll(path, "{taskID}", "{taskId}")
 	path = strings.ReplaceAll(path, "{licenseKey}", "{licenseKey}")
 
 	return path, true
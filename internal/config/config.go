@@ -105,6 +105,16 @@ func (c *AppConfig) defaults() {
 	c.viper.SetDefault("metricsHost", "127.0.0.1")
 	c.viper.SetDefault("metricsPort", 9074)
 	c.viper.SetDefault("metricsBasicAuthUsers", "")
+	c.viper.SetDefault("optimisticUpdateTimeout", 60) // 60 seconds
+	c.viper.SetDefault("reannounceMinInterval", 120)  // 2 minutes
+	c.viper.SetDefault("licenseRefreshInterval", 6)   // 6 hours
+	c.viper.SetDefault("backupInterval", 0)           // disabled
+	c.viper.SetDefault("backupRetention", 7)
+	c.viper.SetDefault("backupDir", "") // Empty means <dataDir>/backups
+	c.viper.SetDefault("timezone", "")  // Empty means the server's local zone
+	c.viper.SetDefault("torrentsPageSize", 300)
+	c.viper.SetDefault("torrentsMaxPageSize", 2000)
+	c.viper.SetDefault("activeWindowMinutes", 0) // state-based
 
 	// HTTP timeout defaults - increased for large qBittorrent instances
 	c.viper.SetDefault("httpTimeouts.readTimeout", 60)   // 60 seconds
@@ -186,6 +196,16 @@ func (c *AppConfig) loadFromEnv() {
 	c.viper.BindEnv("metricsHost", envPrefix+"METRICS_HOST")
 	c.viper.BindEnv("metricsPort", envPrefix+"METRICS_PORT")
 	c.viper.BindEnv("metricsBasicAuthUsers", envPrefix+"METRICS_BASIC_AUTH_USERS")
+	c.viper.BindEnv("optimisticUpdateTimeout", envPrefix+"OPTIMISTIC_UPDATE_TIMEOUT")
+	c.viper.BindEnv("reannounceMinInterval", envPrefix+"REANNOUNCE_MIN_INTERVAL")
+	c.viper.BindEnv("licenseRefreshInterval", envPrefix+"LICENSE_REFRESH_INTERVAL")
+	c.viper.BindEnv("backupInterval", envPrefix+"BACKUP_INTERVAL")
+	c.viper.BindEnv("backupRetention", envPrefix+"BACKUP_RETENTION")
+	c.viper.BindEnv("backupDir", envPrefix+"BACKUP_DIR")
+	c.viper.BindEnv("timezone", envPrefix+"TIMEZONE")
+	c.viper.BindEnv("torrentsPageSize", envPrefix+"TORRENTS_PAGE_SIZE")
+	c.viper.BindEnv("torrentsMaxPageSize", envPrefix+"TORRENTS_MAX_PAGE_SIZE")
+	c.viper.BindEnv("activeWindowMinutes", envPrefix+"ACTIVE_WINDOW_MINUTES")
 
 	// HTTP timeout environment variables
 	c.viper.BindEnv("httpTimeouts.readTimeout", envPrefix+"HTTP_READ_TIMEOUT")
@@ -326,6 +346,53 @@ logLevel = "{{ .logLevel }}"
 # Leave empty to disable authentication (default)
 #metricsBasicAuthUsers = ""
 
+# How long in seconds the UI keeps showing an optimistic torrent state (e.g. paused)
+# while waiting for qBittorrent to confirm it. Increase for slow instances.
+# Once qBittorrent has synced a sixth of this time past the change, its state is trusted.
+# Default: 60
+#optimisticUpdateTimeout = 60
+
+# Minimum time in seconds between reannounces of the same torrent.
+# Reannounces within this window are skipped to avoid tracker throttling or bans. 0 disables.
+# Default: 120
+#reannounceMinInterval = 120
+
+# How often in hours premium licenses are refreshed in the background.
+# Failed refreshes are retried sooner with backoff.
+# Default: 6
+#licenseRefreshInterval = 6
+
+# Scheduled .torrent backups
+# How often in hours the .torrent files of every connected instance are written to a zip archive.
+# Default: 0 (disabled)
+#backupInterval = 24
+
+# Number of backup archives kept per instance
+# Default: 7
+#backupRetention = 7
+
+# Directory backup archives are written to
+# Default: "backups" inside the data directory
+#backupDir = "/var/backups/qui"
+
+# IANA time zone used for "today", "this week" and "this month" counts.
+# Clients can override it per request with the timezone query parameter.
+# Default: "" (the server's local time zone)
+#timezone = "Europe/Berlin"
+
+# Number of torrents a list request returns when it doesn't set a limit.
+# Default: 300
+#torrentsPageSize = 300
+
+# Largest number of torrents a single list request returns. Larger limits are lowered to it.
+# Default: 2000
+#torrentsMaxPageSize = 2000
+
+# Minutes a torrent stays "active" after it last transferred data, for the active and inactive
+# filters, counts and stats. 0 counts only downloading, uploading and forced torrents as active.
+# Default: 0
+#activeWindowMinutes = 10
+
 # HTTP Timeouts (for large qBittorrent instances)
 # Increase these values if you experience timeouts with 10k+ torrents
 [httpTimeouts]
@@ -558,6 +625,14 @@ func (c *AppConfig) GetDatabasePath() string {
 	return filepath.Join(c.dataDir, "qui.db")
 }
 
+// GetBackupDir returns the directory .torrent backups are written to
+func (c *AppConfig) GetBackupDir() string {
+	if c.Config.BackupDir != "" {
+		return c.Config.BackupDir
+	}
+	return filepath.Join(c.dataDir, "backups")
+}
+
 // SetDataDir sets the data directory (used by CLI flags)
 func (c *AppConfig) SetDataDir(dir string) {
 	c.dataDir = dir
@@ -159,6 +159,17 @@ var expectedSchema = map[string][]columnSpec{
 		{Name: "basic_username", Type: "TEXT"},
 		{Name: "basic_password_encrypted", Type: "TEXT"},
 		{Name: "tls_skip_verify", Type: "BOOLEAN"},
+		{Name: "default_paused", Type: "BOOLEAN"},
+		{Name: "default_category", Type: "TEXT"},
+		{Name: "default_tags", Type: "TEXT"},
+		{Name: "default_save_path", Type: "TEXT"},
+		{Name: "rate_limit", Type: "REAL"},
+		{Name: "disable_fuzzy_search", Type: "BOOLEAN"},
+		{Name: "proxy_type", Type: "TEXT"},
+		{Name: "proxy_host", Type: "TEXT"},
+		{Name: "proxy_port", Type: "INTEGER"},
+		{Name: "proxy_username", Type: "TEXT"},
+		{Name: "proxy_password_encrypted", Type: "TEXT"},
 	},
 	"licenses": {
 		{Name: "id", Type: "INTEGER", PrimaryKey: true},
@@ -183,6 +194,24 @@ var expectedSchema = map[string][]columnSpec{
 		{Name: "created_at", Type: "TIMESTAMP"},
 		{Name: "last_used_at", Type: "TIMESTAMP"},
 	},
+	"completed_category_settings": {
+		{Name: "instance_id", Type: "INTEGER", PrimaryKey: true},
+		{Name: "enabled", Type: "BOOLEAN"},
+		{Name: "mappings", Type: "TEXT"},
+		{Name: "updated_at", Type: "TIMESTAMP"},
+	},
+	"disk_space_settings": {
+		{Name: "instance_id", Type: "INTEGER", PrimaryKey: true},
+		{Name: "enabled", Type: "BOOLEAN"},
+		{Name: "pause_below_bytes", Type: "INTEGER"},
+		{Name: "resume_above_bytes", Type: "INTEGER"},
+		{Name: "updated_at", Type: "TIMESTAMP"},
+	},
+	"duplicate_settings": {
+		{Name: "instance_id", Type: "INTEGER", PrimaryKey: true},
+		{Name: "strip_patterns", Type: "TEXT"},
+		{Name: "updated_at", Type: "TIMESTAMP"},
+	},
 	"instance_errors": {
 		{Name: "id", Type: "INTEGER", PrimaryKey: true},
 		{Name: "instance_id", Type: "INTEGER"},
@@ -190,11 +219,52 @@ var expectedSchema = map[string][]columnSpec{
 		{Name: "error_message", Type: "TEXT"},
 		{Name: "occurred_at", Type: "TIMESTAMP"},
 	},
+	"scheduled_actions": {
+		{Name: "id", Type: "INTEGER", PrimaryKey: true},
+		{Name: "instance_id", Type: "INTEGER"},
+		{Name: "hash", Type: "TEXT"},
+		{Name: "action", Type: "TEXT"},
+		{Name: "run_at", Type: "TIMESTAMP"},
+		{Name: "created_at", Type: "TIMESTAMP"},
+	},
+	"refresh_settings": {
+		{Name: "id", Type: "INTEGER", PrimaryKey: true},
+		{Name: "interval_ms", Type: "INTEGER"},
+		{Name: "updated_at", Type: "TIMESTAMP"},
+	},
+	"search_settings": {
+		{Name: "id", Type: "INTEGER", PrimaryKey: true},
+		{Name: "name_weight", Type: "INTEGER"},
+		{Name: "category_weight", Type: "INTEGER"},
+		{Name: "tags_weight", Type: "INTEGER"},
+		{Name: "updated_at", Type: "TIMESTAMP"},
+	},
 	"sessions": {
 		{Name: "token", Type: "TEXT", PrimaryKey: true},
 		{Name: "data", Type: "BLOB"},
 		{Name: "expiry", Type: "REAL"},
 	},
+	"sidebar_metadata": {
+		{Name: "instance_id", Type: "INTEGER", PrimaryKey: true},
+		{Name: "kind", Type: "TEXT", PrimaryKey: true},
+		{Name: "name", Type: "TEXT", PrimaryKey: true},
+		{Name: "color", Type: "TEXT"},
+		{Name: "display_order", Type: "INTEGER"},
+		{Name: "updated_at", Type: "TIMESTAMP"},
+	},
+	"torrent_events": {
+		{Name: "id", Type: "INTEGER", PrimaryKey: true},
+		{Name: "instance_id", Type: "INTEGER"},
+		{Name: "hash", Type: "TEXT"},
+		{Name: "event", Type: "TEXT"},
+		{Name: "state", Type: "TEXT"},
+		{Name: "occurred_at", Type: "TIMESTAMP"},
+	},
+	"user_preferences": {
+		{Name: "user_id", Type: "INTEGER", PrimaryKey: true},
+		{Name: "preferences", Type: "TEXT"},
+		{Name: "updated_at", Type: "TIMESTAMP"},
+	},
 }
 
 var expectedIndexes = map[string][]string{
@@ -203,6 +273,7 @@ var expectedIndexes = map[string][]string{
 	"client_api_keys": {"idx_client_api_keys_key_hash", "idx_client_api_keys_instance_id"},
 	"instance_errors": {"idx_instance_errors_lookup"},
 	"sessions":        {"sessions_expiry_idx"},
+	"torrent_events":  {"idx_torrent_events_torrent", "idx_torrent_events_occurred_at"},
 }
 
 var expectedTriggers = []string{
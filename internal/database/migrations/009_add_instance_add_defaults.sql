@@ -0,0 +1,5 @@
+-- Per-instance defaults applied when adding torrents without explicit options
+ALTER TABLE instances ADD COLUMN default_paused BOOLEAN;
+ALTER TABLE instances ADD COLUMN default_category TEXT NOT NULL DEFAULT '';
+ALTER TABLE instances ADD COLUMN default_tags TEXT NOT NULL DEFAULT '';
+ALTER TABLE instances ADD COLUMN default_save_path TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,8 @@
+-- Single-row search settings; field weights rank matches on more important fields first
+CREATE TABLE search_settings (
+    id INTEGER PRIMARY KEY CHECK (id = 1),
+    name_weight INTEGER NOT NULL DEFAULT 1,
+    category_weight INTEGER NOT NULL DEFAULT 1,
+    tags_weight INTEGER NOT NULL DEFAULT 1,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,2 @@
+-- Outbound request rate limit per instance in requests per second (0 = unlimited)
+ALTER TABLE instances ADD COLUMN rate_limit REAL NOT NULL DEFAULT 0;
@@ -0,0 +1,10 @@
+-- Torrents to resume automatically at a set time, one schedule per torrent
+CREATE TABLE scheduled_resumes (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    instance_id INTEGER NOT NULL,
+    hash TEXT NOT NULL,
+    resume_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (instance_id) REFERENCES instances(id) ON DELETE CASCADE,
+    UNIQUE (instance_id, hash)
+);
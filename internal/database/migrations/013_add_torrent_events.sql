@@ -0,0 +1,13 @@
+-- Notable state transitions of torrents, recorded by diffing sync snapshots
+CREATE TABLE torrent_events (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    instance_id INTEGER NOT NULL,
+    hash TEXT NOT NULL,
+    event TEXT NOT NULL,
+    state TEXT NOT NULL DEFAULT '',
+    occurred_at TIMESTAMP NOT NULL,
+    FOREIGN KEY (instance_id) REFERENCES instances(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_torrent_events_torrent ON torrent_events(instance_id, hash, occurred_at);
+CREATE INDEX idx_torrent_events_occurred_at ON torrent_events(occurred_at);
@@ -0,0 +1,7 @@
+-- Per-instance patterns stripped from torrent names before comparing them for duplicates
+CREATE TABLE duplicate_settings (
+    instance_id INTEGER PRIMARY KEY,
+    strip_patterns TEXT NOT NULL DEFAULT '[]',
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (instance_id) REFERENCES instances(id) ON DELETE CASCADE
+);
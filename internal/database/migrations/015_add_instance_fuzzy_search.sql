@@ -0,0 +1,2 @@
+-- Skip the fuzzy tier of torrent search for the instance (literal matches only)
+ALTER TABLE instances ADD COLUMN disable_fuzzy_search BOOLEAN NOT NULL DEFAULT 0;
@@ -0,0 +1,6 @@
+-- Optional HTTP or SOCKS5 proxy used to reach the instance (empty proxy_type = direct)
+ALTER TABLE instances ADD COLUMN proxy_type TEXT NOT NULL DEFAULT '';
+ALTER TABLE instances ADD COLUMN proxy_host TEXT NOT NULL DEFAULT '';
+ALTER TABLE instances ADD COLUMN proxy_port INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE instances ADD COLUMN proxy_username TEXT NOT NULL DEFAULT '';
+ALTER TABLE instances ADD COLUMN proxy_password_encrypted TEXT;
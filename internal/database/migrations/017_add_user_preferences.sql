@@ -0,0 +1,7 @@
+-- UI preferences (sort, page size, columns, filters...) stored as a JSON object per user
+CREATE TABLE user_preferences (
+    user_id INTEGER PRIMARY KEY,
+    preferences TEXT NOT NULL DEFAULT '{}',
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES user(id) ON DELETE CASCADE
+);
@@ -0,0 +1,6 @@
+-- Single-row UI refresh settings; an interval of 0 follows each instance's sync interval
+CREATE TABLE refresh_settings (
+    id INTEGER PRIMARY KEY CHECK (id = 1),
+    interval_ms INTEGER NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,9 @@
+-- Per-instance low disk space guard: pause downloads below a free space threshold
+CREATE TABLE disk_space_settings (
+    instance_id INTEGER PRIMARY KEY,
+    enabled BOOLEAN NOT NULL DEFAULT 0,
+    pause_below_bytes INTEGER NOT NULL DEFAULT 0,
+    resume_above_bytes INTEGER NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (instance_id) REFERENCES instances(id) ON DELETE CASCADE
+);
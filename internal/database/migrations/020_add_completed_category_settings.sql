@@ -0,0 +1,8 @@
+-- Per-instance mapping of categories completed torrents are moved out of, to seeding categories
+CREATE TABLE completed_category_settings (
+    instance_id INTEGER PRIMARY KEY,
+    enabled BOOLEAN NOT NULL DEFAULT 0,
+    mappings TEXT NOT NULL DEFAULT '{}',
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (instance_id) REFERENCES instances(id) ON DELETE CASCADE
+);
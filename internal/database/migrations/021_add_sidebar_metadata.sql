@@ -0,0 +1,11 @@
+-- Colors and display order qui keeps for an instance's categories and tags, which qBittorrent has no place for
+CREATE TABLE sidebar_metadata (
+    instance_id INTEGER NOT NULL,
+    kind TEXT NOT NULL CHECK (kind IN ('category', 'tag')),
+    name TEXT NOT NULL,
+    color TEXT,
+    display_order INTEGER,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (instance_id, kind, name),
+    FOREIGN KEY (instance_id) REFERENCES instances(id) ON DELETE CASCADE
+);
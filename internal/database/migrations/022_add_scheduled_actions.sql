@@ -0,0 +1,17 @@
+-- Torrents to resume or stop at a set time, one schedule per torrent and action. Replaces
+-- scheduled_resumes now that torrents can be stopped on a schedule too.
+CREATE TABLE scheduled_actions (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    instance_id INTEGER NOT NULL,
+    hash TEXT NOT NULL,
+    action TEXT NOT NULL CHECK (action IN ('resume', 'stop')),
+    run_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (instance_id) REFERENCES instances(id) ON DELETE CASCADE,
+    UNIQUE (instance_id, hash, action)
+);
+
+INSERT INTO scheduled_actions (instance_id, hash, action, run_at, created_at)
+SELECT instance_id, hash, 'resume', resume_at, created_at FROM scheduled_resumes;
+
+DROP TABLE scheduled_resumes;
@@ -22,6 +22,34 @@ type Config struct {
 	MetricsPort           int    `toml:"metricsPort" mapstructure:"metricsPort"`
 	MetricsBasicAuthUsers string `toml:"metricsBasicAuthUsers" mapstructure:"metricsBasicAuthUsers"`
 
+	// OptimisticUpdateTimeout is how long (seconds) an optimistic torrent state is kept before being discarded
+	OptimisticUpdateTimeout int `toml:"optimisticUpdateTimeout" mapstructure:"optimisticUpdateTimeout"`
+
+	// ReannounceMinInterval is the minimum time (seconds) between reannounces of the same torrent; 0 disables
+	ReannounceMinInterval int `toml:"reannounceMinInterval" mapstructure:"reannounceMinInterval"`
+
+	// LicenseRefreshInterval is how often (hours) premium licenses are refreshed in the background
+	LicenseRefreshInterval int `toml:"licenseRefreshInterval" mapstructure:"licenseRefreshInterval"`
+
+	// BackupInterval is how often (hours) .torrent backups of every instance are written; 0 disables
+	BackupInterval int `toml:"backupInterval" mapstructure:"backupInterval"`
+	// BackupRetention is how many backups are kept per instance
+	BackupRetention int `toml:"backupRetention" mapstructure:"backupRetention"`
+	// BackupDir is where backups are written; empty means a backups directory inside the data directory
+	BackupDir string `toml:"backupDir" mapstructure:"backupDir"`
+
+	// TorrentsPageSize is the number of torrents a list request returns when it sets no limit
+	TorrentsPageSize int `toml:"torrentsPageSize" mapstructure:"torrentsPageSize"`
+
+	// TorrentsMaxPageSize is the largest number of torrents a single list request returns
+	TorrentsMaxPageSize int `toml:"torrentsMaxPageSize" mapstructure:"torrentsMaxPageSize"`
+
+	// ActiveWindowMinutes makes a torrent active while it transfers and for this many minutes after; 0 keeps the state-based definition
+	ActiveWindowMinutes int `toml:"activeWindowMinutes" mapstructure:"activeWindowMinutes"`
+
+	// Timezone is the IANA time zone day, week and month boundaries are computed in; empty means the server's local zone
+	Timezone string `toml:"timezone" mapstructure:"timezone"`
+
 	HTTPTimeouts HTTPTimeouts `toml:"httpTimeouts" mapstructure:"httpTimeouts"`
 }
 
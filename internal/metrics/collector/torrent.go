@@ -158,21 +158,19 @@ func (c *TorrentCollector) Collect(ch chan<- prometheus.Metric) {
 			continue
 		}
 
-		// Use GetTorrentsWithFilters with no filters to get all torrents and counts
-		// This uses the same data source as the UI for consistency
-		response, err := c.syncManager.GetTorrentsWithFilters(ctx, instance.ID, 100000, 0, "", "", "", qbittorrent.FilterOptions{})
+		// Use the sidebar counts so metrics match what the UI shows
+		counts, err := c.syncManager.GetTorrentCounts(ctx, instance.ID)
 		if err != nil {
 			log.Warn().
 				Err(err).
 				Int("instanceID", instance.ID).
 				Str("instanceName", instanceName).
-				Msg("Failed to get torrents with filters for metrics")
+				Msg("Failed to get torrent counts for metrics")
 			c.reportError(ch, instanceIDStr, instanceName, "torrent_counts")
 			continue
 		}
 
-		if response != nil && response.Counts != nil && response.Counts.Status != nil {
-			counts := response.Counts
+		if counts != nil && counts.Status != nil {
 			if downloading, ok := counts.Status["downloading"]; ok {
 				ch <- prometheus.MustNewConstMetric(
 					c.torrentsDownloadingDesc,
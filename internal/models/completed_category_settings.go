@@ -0,0 +1,102 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidCompletedCategorySettings is returned for category mappings that can't be applied
+var ErrInvalidCompletedCategorySettings = errors.New("invalid completed category settings")
+
+// CompletedCategorySettings moves torrents to a seeding category when they finish downloading.
+// Mappings maps the category a torrent downloads in to the category it seeds in; the empty
+// category maps uncategorized torrents. Torrents in unmapped categories are left alone.
+type CompletedCategorySettings struct {
+	Enabled  bool              `json:"enabled"`
+	Mappings map[string]string `json:"mappings"`
+}
+
+// DefaultCompletedCategorySettings moves nothing
+func DefaultCompletedCategorySettings() CompletedCategorySettings {
+	return CompletedCategorySettings{Mappings: map[string]string{}}
+}
+
+// Validate checks that every mapping moves torrents into a different, named category
+func (s CompletedCategorySettings) Validate() error {
+	for from, to := range s.Mappings {
+		if to == "" {
+			return fmt.Errorf("%w: category %q has no target", ErrInvalidCompletedCategorySettings, from)
+		}
+		if to == from {
+			return fmt.Errorf("%w: category %q maps to itself", ErrInvalidCompletedCategorySettings, from)
+		}
+	}
+	return nil
+}
+
+type CompletedCategorySettingsStore struct {
+	db *sql.DB
+}
+
+func NewCompletedCategorySettingsStore(db *sql.DB) *CompletedCategorySettingsStore {
+	return &CompletedCategorySettingsStore{db: db}
+}
+
+// Get returns the completed category settings of an instance, or the defaults if none were saved
+func (s *CompletedCategorySettingsStore) Get(ctx context.Context, instanceID int) (CompletedCategorySettings, error) {
+	query := `SELECT enabled, mappings FROM completed_category_settings WHERE instance_id = ?`
+
+	var enabled bool
+	var raw string
+	err := s.db.QueryRowContext(ctx, query, instanceID).Scan(&enabled, &raw)
+	if errors.Is(err, sql.ErrNoRows) {
+		return DefaultCompletedCategorySettings(), nil
+	}
+	if err != nil {
+		return CompletedCategorySettings{}, err
+	}
+
+	return decodeCompletedCategorySettings(enabled, raw)
+}
+
+// Update validates and stores the completed category settings of an instance
+func (s *CompletedCategorySettingsStore) Update(ctx context.Context, instanceID int, settings CompletedCategorySettings) error {
+	if settings.Mappings == nil {
+		settings.Mappings = map[string]string{}
+	}
+	if err := settings.Validate(); err != nil {
+		return err
+	}
+
+	raw, err := json.Marshal(settings.Mappings)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO completed_category_settings (instance_id, enabled, mappings)
+		VALUES (?, ?, ?)
+		ON CONFLICT(instance_id) DO UPDATE SET
+			enabled = excluded.enabled,
+			mappings = excluded.mappings,
+			updated_at = CURRENT_TIMESTAMP
+	`
+
+	_, err = s.db.ExecContext(ctx, query, instanceID, settings.Enabled, string(raw))
+	return err
+}
+
+func decodeCompletedCategorySettings(enabled bool, raw string) (CompletedCategorySettings, error) {
+	settings := DefaultCompletedCategorySettings()
+	settings.Enabled = enabled
+	if err := json.Unmarshal([]byte(raw), &settings.Mappings); err != nil {
+		return CompletedCategorySettings{}, fmt.Errorf("failed to decode category mappings: %w", err)
+	}
+	return settings, nil
+}
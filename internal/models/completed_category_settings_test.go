@@ -0,0 +1,32 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompletedCategorySettingsValidate(t *testing.T) {
+	assert.NoError(t, DefaultCompletedCategorySettings().Validate())
+	assert.NoError(t, CompletedCategorySettings{Enabled: true, Mappings: map[string]string{"downloading": "seeding", "": "seeding"}}.Validate())
+
+	for _, invalid := range []map[string]string{
+		{"downloading": ""},
+		{"seeding": "seeding"},
+	} {
+		assert.ErrorIs(t, CompletedCategorySettings{Enabled: true, Mappings: invalid}.Validate(), ErrInvalidCompletedCategorySettings, "%v", invalid)
+	}
+}
+
+func TestDecodeCompletedCategorySettings(t *testing.T) {
+	settings, err := decodeCompletedCategorySettings(true, `{"downloading":"seeding"}`)
+	require.NoError(t, err)
+	assert.Equal(t, CompletedCategorySettings{Enabled: true, Mappings: map[string]string{"downloading": "seeding"}}, settings)
+
+	_, err = decodeCompletedCategorySettings(false, "not json")
+	assert.Error(t, err)
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidDiskSpaceSettings is returned for inconsistent low disk space thresholds
+var ErrInvalidDiskSpaceSettings = errors.New("invalid disk space settings")
+
+// DiskSpaceSettings configures the low disk space guard of an instance. Downloads are paused
+// when free space drops below PauseBelowBytes. They are resumed automatically once free space
+// is back above ResumeAboveBytes; zero leaves resuming to the user. The gap between the two
+// thresholds keeps torrents from flapping between paused and downloading.
+type DiskSpaceSettings struct {
+	Enabled          bool  `json:"enabled"`
+	PauseBelowBytes  int64 `json:"pauseBelowBytes"`
+	ResumeAboveBytes int64 `json:"resumeAboveBytes"`
+}
+
+// Validate checks that an enabled guard has a pause threshold and that the resume threshold,
+// if set, lies above it
+func (s DiskSpaceSettings) Validate() error {
+	if s.PauseBelowBytes < 0 || s.ResumeAboveBytes < 0 {
+		return fmt.Errorf("%w: thresholds must not be negative", ErrInvalidDiskSpaceSettings)
+	}
+	if s.Enabled && s.PauseBelowBytes == 0 {
+		return fmt.Errorf("%w: pauseBelowBytes is required when enabled", ErrInvalidDiskSpaceSettings)
+	}
+	if s.ResumeAboveBytes != 0 && s.ResumeAboveBytes <= s.PauseBelowBytes {
+		return fmt.Errorf("%w: resumeAboveBytes must be greater than pauseBelowBytes", ErrInvalidDiskSpaceSettings)
+	}
+	return nil
+}
+
+type DiskSpaceSettingsStore struct {
+	db *sql.DB
+}
+
+func NewDiskSpaceSettingsStore(db *sql.DB) *DiskSpaceSettingsStore {
+	return &DiskSpaceSettingsStore{db: db}
+}
+
+// Get returns the disk space settings of an instance, or a disabled guard if none were saved
+func (s *DiskSpaceSettingsStore) Get(ctx context.Context, instanceID int) (DiskSpaceSettings, error) {
+	query := `SELECT enabled, pause_below_bytes, resume_above_bytes FROM disk_space_settings WHERE instance_id = ?`
+
+	var settings DiskSpaceSettings
+	err := s.db.QueryRowContext(ctx, query, instanceID).Scan(&settings.Enabled, &settings.PauseBelowBytes, &settings.ResumeAboveBytes)
+	if errors.Is(err, sql.ErrNoRows) {
+		return DiskSpaceSettings{}, nil
+	}
+	if err != nil {
+		return DiskSpaceSettings{}, err
+	}
+
+	return settings, nil
+}
+
+// ListEnabled returns the settings of every instance with an enabled guard
+func (s *DiskSpaceSettingsStore) ListEnabled(ctx context.Context) (map[int]DiskSpaceSettings, error) {
+	query := `SELECT instance_id, enabled, pause_below_bytes, resume_above_bytes FROM disk_space_settings WHERE enabled = 1`
+
+	rows, err := s.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	settings := make(map[int]DiskSpaceSettings)
+	for rows.Next() {
+		var instanceID int
+		var instance DiskSpaceSettings
+		if err := rows.Scan(&instanceID, &instance.Enabled, &instance.PauseBelowBytes, &instance.ResumeAboveBytes); err != nil {
+			return nil, err
+		}
+		settings[instanceID] = instance
+	}
+	return settings, rows.Err()
+}
+
+// Update validates and stores the disk space settings of an instance
+func (s *DiskSpaceSettingsStore) Update(ctx context.Context, instanceID int, settings DiskSpaceSettings) error {
+	if err := settings.Validate(); err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO disk_space_settings (instance_id, enabled, pause_below_bytes, resume_above_bytes)
+		VALUES (?, ?, ?, ?)
+		ON CONFLICT(instance_id) DO UPDATE SET
+			enabled = excluded.enabled,
+			pause_below_bytes = excluded.pause_below_bytes,
+			resume_above_bytes = excluded.resume_above_bytes,
+			updated_at = CURRENT_TIMESTAMP
+	`
+
+	_, err := s.db.ExecContext(ctx, query, instanceID, settings.Enabled, settings.PauseBelowBytes, settings.ResumeAboveBytes)
+	return err
+}
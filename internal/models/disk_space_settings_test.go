@@ -0,0 +1,25 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiskSpaceSettingsValidate(t *testing.T) {
+	assert.NoError(t, DiskSpaceSettings{}.Validate(), "disabled guard needs no thresholds")
+	assert.NoError(t, DiskSpaceSettings{Enabled: true, PauseBelowBytes: 10 << 30}.Validate(), "manual resume")
+	assert.NoError(t, DiskSpaceSettings{Enabled: true, PauseBelowBytes: 10 << 30, ResumeAboveBytes: 20 << 30}.Validate())
+
+	for _, invalid := range []DiskSpaceSettings{
+		{Enabled: true},
+		{Enabled: true, PauseBelowBytes: -1},
+		{Enabled: true, PauseBelowBytes: 10 << 30, ResumeAboveBytes: 10 << 30},
+		{Enabled: true, PauseBelowBytes: 10 << 30, ResumeAboveBytes: 5 << 30},
+	} {
+		assert.ErrorIs(t, invalid.Validate(), ErrInvalidDiskSpaceSettings, "%+v", invalid)
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+// MaxDuplicateStripPatterns caps how many strip patterns an instance can have
+const MaxDuplicateStripPatterns = 100
+
+// ErrInvalidStripPattern is returned when a duplicate strip pattern is not a valid regular expression
+var ErrInvalidStripPattern = errors.New("invalid strip pattern")
+
+// DuplicateSettings controls how torrent names are normalized before they are compared for
+// duplicates. Each strip pattern is a case-insensitive regular expression; matches are removed
+// from the name before separators are normalized.
+type DuplicateSettings struct {
+	StripPatterns []string `json:"stripPatterns"`
+}
+
+// DefaultDuplicateSettings strips nothing, so names only have their separators normalized
+func DefaultDuplicateSettings() DuplicateSettings {
+	return DuplicateSettings{StripPatterns: []string{}}
+}
+
+// CompileStripPatterns compiles strip patterns as case-insensitive regular expressions
+func CompileStripPatterns(patterns []string) ([]*regexp.Regexp, error) {
+	if len(patterns) > MaxDuplicateStripPatterns {
+		return nil, fmt.Errorf("%w: at most %d patterns are allowed", ErrInvalidStripPattern, MaxDuplicateStripPatterns)
+	}
+
+	compiled := make([]*regexp.Regexp, 0, len(patterns))
+	for _, pattern := range patterns {
+		if pattern == "" {
+			return nil, fmt.Errorf("%w: pattern is empty", ErrInvalidStripPattern)
+		}
+		re, err := regexp.Compile("(?i)" + pattern)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q: %v", ErrInvalidStripPattern, pattern, err)
+		}
+		compiled = append(compiled, re)
+	}
+	return compiled, nil
+}
+
+type DuplicateSettingsStore struct {
+	db *sql.DB
+}
+
+func NewDuplicateSettingsStore(db *sql.DB) *DuplicateSettingsStore {
+	return &DuplicateSettingsStore{db: db}
+}
+
+// Get returns the duplicate settings of an instance, or the defaults if none were saved
+func (s *DuplicateSettingsStore) Get(ctx context.Context, instanceID int) (DuplicateSettings, error) {
+	var raw string
+	err := s.db.QueryRowContext(ctx, `SELECT strip_patterns FROM duplicate_settings WHERE instance_id = ?`, instanceID).Scan(&raw)
+	if errors.Is(err, sql.ErrNoRows) {
+		return DefaultDuplicateSettings(), nil
+	}
+	if err != nil {
+		return DuplicateSettings{}, err
+	}
+
+	return decodeDuplicateSettings(raw)
+}
+
+// List returns the saved duplicate settings of every instance that has them
+func (s *DuplicateSettingsStore) List(ctx context.Context) (map[int]DuplicateSettings, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT instance_id, strip_patterns FROM duplicate_settings`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	settings := make(map[int]DuplicateSettings)
+	for rows.Next() {
+		var instanceID int
+		var raw string
+		if err := rows.Scan(&instanceID, &raw); err != nil {
+			return nil, err
+		}
+		decoded, err := decodeDuplicateSettings(raw)
+		if err != nil {
+			return nil, err
+		}
+		settings[instanceID] = decoded
+	}
+	return settings, rows.Err()
+}
+
+// Update validates and stores the duplicate settings of an instance
+func (s *DuplicateSettingsStore) Update(ctx context.Context, instanceID int, settings DuplicateSettings) error {
+	if settings.StripPatterns == nil {
+		settings.StripPatterns = []string{}
+	}
+	if _, err := CompileStripPatterns(settings.StripPatterns); err != nil {
+		return err
+	}
+
+	raw, err := json.Marshal(settings.StripPatterns)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO duplicate_settings (instance_id, strip_patterns)
+		VALUES (?, ?)
+		ON CONFLICT(instance_id) DO UPDATE SET
+			strip_patterns = excluded.strip_patterns,
+			updated_at = CURRENT_TIMESTAMP
+	`
+
+	_, err = s.db.ExecContext(ctx, query, instanceID, string(raw))
+	return err
+}
+
+func decodeDuplicateSettings(raw string) (DuplicateSettings, error) {
+	settings := DefaultDuplicateSettings()
+	if err := json.Unmarshal([]byte(raw), &settings.StripPatterns); err != nil {
+		return DuplicateSettings{}, fmt.Errorf("failed to decode strip patterns: %w", err)
+	}
+	return settings, nil
+}
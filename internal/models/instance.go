@@ -23,32 +23,94 @@ import (
 
 var ErrInstanceNotFound = errors.New("instance not found")
 
+var ErrInvalidRateLimit = errors.New("rate limit must not be negative")
+
+// ErrInvalidHost is returned when an instance host is not an http or https URL
+var ErrInvalidHost = errors.New("invalid host")
+
+// ErrDuplicateInstance is returned when another instance already uses the same host and username
+var ErrDuplicateInstance = errors.New("an instance with this host and username already exists")
+
 type Instance struct {
-	ID                     int     `json:"id"`
-	Name                   string  `json:"name"`
-	Host                   string  `json:"host"`
-	Username               string  `json:"username"`
-	PasswordEncrypted      string  `json:"-"`
-	BasicUsername          *string `json:"basic_username,omitempty"`
-	BasicPasswordEncrypted *string `json:"-"`
-	TLSSkipVerify          bool    `json:"tlsSkipVerify"`
+	ID                     int                 `json:"id"`
+	Name                   string              `json:"name"`
+	Host                   string              `json:"host"`
+	Username               string              `json:"username"`
+	PasswordEncrypted      string              `json:"-"`
+	BasicUsername          *string             `json:"basic_username,omitempty"`
+	BasicPasswordEncrypted *string             `json:"-"`
+	TLSSkipVerify          bool                `json:"tlsSkipVerify"`
+	AddDefaults            InstanceAddDefaults `json:"addDefaults"`
+	RateLimit              float64             `json:"rateLimit"`          // Outbound requests per second, 0 is unlimited
+	DisableFuzzySearch     bool                `json:"disableFuzzySearch"` // Search only returns literal matches
+	Proxy                  InstanceProxy       `json:"proxy"`
+	ProxyPasswordEncrypted *string             `json:"-"`
+}
+
+// InstanceAddDefaults are applied when adding torrents to an instance without explicit options
+type InstanceAddDefaults struct {
+	Paused   *bool  `json:"paused,omitempty"` // nil leaves qBittorrent's own preference in effect
+	Category string `json:"category,omitempty"`
+	Tags     string `json:"tags,omitempty"`
+	SavePath string `json:"savePath,omitempty"`
+}
+
+// Apply fills options the caller did not set with the instance defaults.
+// Caller-provided options always win.
+func (d InstanceAddDefaults) Apply(options map[string]string) map[string]string {
+	if options == nil {
+		options = make(map[string]string)
+	}
+
+	if d.Paused != nil {
+		_, hasPaused := options["paused"]
+		_, hasStopped := options["stopped"]
+		if !hasPaused && !hasStopped {
+			value := "false"
+			if *d.Paused {
+				value = "true"
+			}
+			options["paused"] = value
+			options["stopped"] = value
+		}
+	}
+
+	if _, ok := options["category"]; !ok && d.Category != "" {
+		options["category"] = d.Category
+	}
+
+	if _, ok := options["tags"]; !ok && d.Tags != "" {
+		options["tags"] = d.Tags
+	}
+
+	// A save path only makes sense when the caller hasn't chosen automatic management
+	if _, ok := options["savepath"]; !ok && d.SavePath != "" && options["autoTMM"] != "true" {
+		options["savepath"] = d.SavePath
+		options["autoTMM"] = "false"
+	}
+
+	return options
 }
 
 func (i Instance) MarshalJSON() ([]byte, error) {
 	// Create the JSON structure with redacted password fields
 	return json.Marshal(&struct {
-		ID              int        `json:"id"`
-		Name            string     `json:"name"`
-		Host            string     `json:"host"`
-		Username        string     `json:"username"`
-		Password        string     `json:"password,omitempty"`
-		BasicUsername   *string    `json:"basic_username,omitempty"`
-		BasicPassword   string     `json:"basic_password,omitempty"`
-		TLSSkipVerify   bool       `json:"tlsSkipVerify"`
-		IsActive        bool       `json:"is_active"`
-		LastConnectedAt *time.Time `json:"last_connected_at,omitempty"`
-		CreatedAt       time.Time  `json:"created_at"`
-		UpdatedAt       time.Time  `json:"updated_at"`
+		ID                 int                 `json:"id"`
+		Name               string              `json:"name"`
+		Host               string              `json:"host"`
+		Username           string              `json:"username"`
+		Password           string              `json:"password,omitempty"`
+		BasicUsername      *string             `json:"basic_username,omitempty"`
+		BasicPassword      string              `json:"basic_password,omitempty"`
+		TLSSkipVerify      bool                `json:"tlsSkipVerify"`
+		AddDefaults        InstanceAddDefaults `json:"addDefaults"`
+		RateLimit          float64             `json:"rateLimit"`
+		DisableFuzzySearch bool                `json:"disableFuzzySearch"`
+		Proxy              InstanceProxy       `json:"proxy"`
+		IsActive           bool                `json:"is_active"`
+		LastConnectedAt    *time.Time          `json:"last_connected_at,omitempty"`
+		CreatedAt          time.Time           `json:"created_at"`
+		UpdatedAt          time.Time           `json:"updated_at"`
 	}{
 		ID:            i.ID,
 		Name:          i.Name,
@@ -62,25 +124,33 @@ func (i Instance) MarshalJSON() ([]byte, error) {
 			}
 			return ""
 		}(),
-		TLSSkipVerify: i.TLSSkipVerify,
+		TLSSkipVerify:      i.TLSSkipVerify,
+		AddDefaults:        i.AddDefaults,
+		RateLimit:          i.RateLimit,
+		DisableFuzzySearch: i.DisableFuzzySearch,
+		Proxy:              i.RedactedProxy(),
 	})
 }
 
 func (i *Instance) UnmarshalJSON(data []byte) error {
 	// Temporary struct for unmarshaling
 	var temp struct {
-		ID              int        `json:"id"`
-		Name            string     `json:"name"`
-		Host            string     `json:"host"`
-		Username        string     `json:"username"`
-		Password        string     `json:"password,omitempty"`
-		BasicUsername   *string    `json:"basic_username,omitempty"`
-		BasicPassword   string     `json:"basic_password,omitempty"`
-		TLSSkipVerify   *bool      `json:"tlsSkipVerify,omitempty"`
-		IsActive        bool       `json:"is_active"`
-		LastConnectedAt *time.Time `json:"last_connected_at,omitempty"`
-		CreatedAt       time.Time  `json:"created_at"`
-		UpdatedAt       time.Time  `json:"updated_at"`
+		ID                 int                  `json:"id"`
+		Name               string               `json:"name"`
+		Host               string               `json:"host"`
+		Username           string               `json:"username"`
+		Password           string               `json:"password,omitempty"`
+		BasicUsername      *string              `json:"basic_username,omitempty"`
+		BasicPassword      string               `json:"basic_password,omitempty"`
+		TLSSkipVerify      *bool                `json:"tlsSkipVerify,omitempty"`
+		AddDefaults        *InstanceAddDefaults `json:"addDefaults,omitempty"`
+		RateLimit          *float64             `json:"rateLimit,omitempty"`
+		DisableFuzzySearch *bool                `json:"disableFuzzySearch,omitempty"`
+		Proxy              *InstanceProxy       `json:"proxy,omitempty"`
+		IsActive           bool                 `json:"is_active"`
+		LastConnectedAt    *time.Time           `json:"last_connected_at,omitempty"`
+		CreatedAt          time.Time            `json:"created_at"`
+		UpdatedAt          time.Time            `json:"updated_at"`
 	}
 
 	if err := json.Unmarshal(data, &temp); err != nil {
@@ -98,6 +168,27 @@ func (i *Instance) UnmarshalJSON(data []byte) error {
 		i.TLSSkipVerify = *temp.TLSSkipVerify
 	}
 
+	if temp.AddDefaults != nil {
+		i.AddDefaults = *temp.AddDefaults
+	}
+
+	if temp.RateLimit != nil {
+		i.RateLimit = *temp.RateLimit
+	}
+
+	if temp.DisableFuzzySearch != nil {
+		i.DisableFuzzySearch = *temp.DisableFuzzySearch
+	}
+
+	if temp.Proxy != nil {
+		i.Proxy = *temp.Proxy
+		// Don't overwrite the stored password with the redacted placeholder
+		if password := i.Proxy.Password; password != "" && !domain.IsRedactedString(password) {
+			i.ProxyPasswordEncrypted = &password
+		}
+		i.Proxy.Password = ""
+	}
+
 	// Handle password - don't overwrite if redacted
 	if temp.Password != "" && !domain.IsRedactedString(temp.Password) {
 		i.PasswordEncrypted = temp.Password
@@ -178,14 +269,15 @@ func (s *InstanceStore) decrypt(ciphertext string) (string, error) {
 	return string(plaintext), nil
 }
 
-// validateAndNormalizeHost validates and normalizes a qBittorrent instance host URL
+// validateAndNormalizeHost validates a qBittorrent instance host URL and returns its canonical
+// form: http:// when no scheme is given, a lowercase host and no trailing slash
 func validateAndNormalizeHost(rawHost string) (string, error) {
 	// Trim whitespace
 	rawHost = strings.TrimSpace(rawHost)
 
 	// Check for empty host
 	if rawHost == "" {
-		return "", errors.New("host cannot be empty")
+		return "", fmt.Errorf("%w: host cannot be empty", ErrInvalidHost)
 	}
 
 	// Check if host already has a valid scheme
@@ -197,28 +289,79 @@ func validateAndNormalizeHost(rawHost string) (string, error) {
 	// Parse the URL
 	u, err := url.Parse(rawHost)
 	if err != nil {
-		return "", fmt.Errorf("invalid URL format: %w", err)
+		return "", fmt.Errorf("%w: invalid URL format: %w", ErrInvalidHost, err)
 	}
 
 	// Validate scheme
 	if u.Scheme != "http" && u.Scheme != "https" {
-		return "", fmt.Errorf("unsupported scheme %q: must be http or https", u.Scheme)
+		return "", fmt.Errorf("%w: unsupported scheme %q: must be http or https", ErrInvalidHost, u.Scheme)
 	}
 
 	// Validate host
 	if u.Host == "" {
-		return "", errors.New("URL must include a host")
+		return "", fmt.Errorf("%w: URL must include a host", ErrInvalidHost)
 	}
 
+	// API paths are appended to the host, so a trailing slash would double up
+	u.Host = strings.ToLower(u.Host)
+	u.Path = strings.TrimRight(u.Path, "/")
+	u.RawPath = ""
+
 	return u.String(), nil
 }
 
-func (s *InstanceStore) Create(ctx context.Context, name, rawHost, username, password string, basicUsername, basicPassword *string, tlsSkipVerify bool) (*Instance, error) {
+// hostIdentity reduces a normalized host URL to the form used to detect duplicate instances:
+// lowercase scheme and host, without a trailing slash
+func hostIdentity(host string) string {
+	u, err := url.Parse(host)
+	if err != nil {
+		return strings.TrimSuffix(strings.ToLower(host), "/")
+	}
+	u.Scheme = strings.ToLower(u.Scheme)
+	u.Host = strings.ToLower(u.Host)
+	u.Path = strings.TrimSuffix(u.Path, "/")
+	u.RawPath = ""
+	return u.String()
+}
+
+// checkDuplicate returns ErrDuplicateInstance if an instance other than excludeID already
+// connects to the same host with the same username. An instance that keeps its host and username
+// passes, so one created as an explicit duplicate can still be edited.
+func (s *InstanceStore) checkDuplicate(ctx context.Context, normalizedHost, username string, excludeID int) error {
+	instances, err := s.List(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check for duplicate instances: %w", err)
+	}
+
+	identity := hostIdentity(normalizedHost)
+	for _, instance := range instances {
+		if instance.ID == excludeID && instance.Username == username && hostIdentity(instance.Host) == identity {
+			return nil
+		}
+	}
+	for _, instance := range instances {
+		if instance.ID != excludeID && instance.Username == username && hostIdentity(instance.Host) == identity {
+			return fmt.Errorf("%w: %q", ErrDuplicateInstance, instance.Name)
+		}
+	}
+	return nil
+}
+
+// Create adds a new instance. Unless allowDuplicate is set, an instance using the same host
+// and username as an existing one is rejected with ErrDuplicateInstance.
+func (s *InstanceStore) Create(ctx context.Context, name, rawHost, username, password string, basicUsername, basicPassword *string, tlsSkipVerify, allowDuplicate bool) (*Instance, error) {
 	// Validate and normalize the host
 	normalizedHost, err := validateAndNormalizeHost(rawHost)
 	if err != nil {
 		return nil, err
 	}
+
+	if !allowDuplicate {
+		if err := s.checkDuplicate(ctx, normalizedHost, username, 0); err != nil {
+			return nil, err
+		}
+	}
+
 	// Encrypt the password
 	encryptedPassword, err := s.encrypt(password)
 	if err != nil {
@@ -238,7 +381,9 @@ func (s *InstanceStore) Create(ctx context.Context, name, rawHost, username, pas
 	query := `
 		INSERT INTO instances (name, host, username, password_encrypted, basic_username, basic_password_encrypted, tls_skip_verify) 
 		VALUES (?, ?, ?, ?, ?, ?, ?)
-		RETURNING id, name, host, username, password_encrypted, basic_username, basic_password_encrypted, tls_skip_verify
+		RETURNING id, name, host, username, password_encrypted, basic_username, basic_password_encrypted, tls_skip_verify,
+			default_paused, default_category, default_tags, default_save_path, rate_limit, disable_fuzzy_search,
+			proxy_type, proxy_host, proxy_port, proxy_username, proxy_password_encrypted
 	`
 
 	instance := &Instance{}
@@ -251,6 +396,17 @@ func (s *InstanceStore) Create(ctx context.Context, name, rawHost, username, pas
 		&instance.BasicUsername,
 		&instance.BasicPasswordEncrypted,
 		&instance.TLSSkipVerify,
+		&instance.AddDefaults.Paused,
+		&instance.AddDefaults.Category,
+		&instance.AddDefaults.Tags,
+		&instance.AddDefaults.SavePath,
+		&instance.RateLimit,
+		&instance.DisableFuzzySearch,
+		&instance.Proxy.Type,
+		&instance.Proxy.Host,
+		&instance.Proxy.Port,
+		&instance.Proxy.Username,
+		&instance.ProxyPasswordEncrypted,
 	)
 
 	if err != nil {
@@ -262,7 +418,9 @@ func (s *InstanceStore) Create(ctx context.Context, name, rawHost, username, pas
 
 func (s *InstanceStore) Get(ctx context.Context, id int) (*Instance, error) {
 	query := `
-		SELECT id, name, host, username, password_encrypted, basic_username, basic_password_encrypted, tls_skip_verify 
+		SELECT id, name, host, username, password_encrypted, basic_username, basic_password_encrypted, tls_skip_verify,
+			default_paused, default_category, default_tags, default_save_path, rate_limit, disable_fuzzy_search,
+			proxy_type, proxy_host, proxy_port, proxy_username, proxy_password_encrypted
 		FROM instances 
 		WHERE id = ?
 	`
@@ -277,6 +435,17 @@ func (s *InstanceStore) Get(ctx context.Context, id int) (*Instance, error) {
 		&instance.BasicUsername,
 		&instance.BasicPasswordEncrypted,
 		&instance.TLSSkipVerify,
+		&instance.AddDefaults.Paused,
+		&instance.AddDefaults.Category,
+		&instance.AddDefaults.Tags,
+		&instance.AddDefaults.SavePath,
+		&instance.RateLimit,
+		&instance.DisableFuzzySearch,
+		&instance.Proxy.Type,
+		&instance.Proxy.Host,
+		&instance.Proxy.Port,
+		&instance.Proxy.Username,
+		&instance.ProxyPasswordEncrypted,
 	)
 
 	if err != nil {
@@ -291,7 +460,9 @@ func (s *InstanceStore) Get(ctx context.Context, id int) (*Instance, error) {
 
 func (s *InstanceStore) List(ctx context.Context) ([]*Instance, error) {
 	query := `
-		SELECT id, name, host, username, password_encrypted, basic_username, basic_password_encrypted, tls_skip_verify 
+		SELECT id, name, host, username, password_encrypted, basic_username, basic_password_encrypted, tls_skip_verify,
+			default_paused, default_category, default_tags, default_save_path, rate_limit, disable_fuzzy_search,
+			proxy_type, proxy_host, proxy_port, proxy_username, proxy_password_encrypted
 		FROM instances
 		ORDER BY name ASC
 	`
@@ -314,6 +485,17 @@ func (s *InstanceStore) List(ctx context.Context) ([]*Instance, error) {
 			&instance.BasicUsername,
 			&instance.BasicPasswordEncrypted,
 			&instance.TLSSkipVerify,
+			&instance.AddDefaults.Paused,
+			&instance.AddDefaults.Category,
+			&instance.AddDefaults.Tags,
+			&instance.AddDefaults.SavePath,
+			&instance.RateLimit,
+			&instance.DisableFuzzySearch,
+			&instance.Proxy.Type,
+			&instance.Proxy.Host,
+			&instance.Proxy.Port,
+			&instance.Proxy.Username,
+			&instance.ProxyPasswordEncrypted,
 		)
 		if err != nil {
 			return nil, err
@@ -324,13 +506,19 @@ func (s *InstanceStore) List(ctx context.Context) ([]*Instance, error) {
 	return instances, rows.Err()
 }
 
-func (s *InstanceStore) Update(ctx context.Context, id int, name, rawHost, username, password string, basicUsername, basicPassword *string, tlsSkipVerify *bool) (*Instance, error) {
+func (s *InstanceStore) Update(ctx context.Context, id int, name, rawHost, username, password string, basicUsername, basicPassword *string, tlsSkipVerify *bool, addDefaults *InstanceAddDefaults, rateLimit *float64, disableFuzzySearch *bool, proxy *InstanceProxy, allowDuplicate bool) (*Instance, error) {
 	// Validate and normalize the host
 	normalizedHost, err := validateAndNormalizeHost(rawHost)
 	if err != nil {
 		return nil, err
 	}
 
+	if !allowDuplicate {
+		if err := s.checkDuplicate(ctx, normalizedHost, username, id); err != nil {
+			return nil, err
+		}
+	}
+
 	// Start building the update query
 	query := `UPDATE instances SET name = ?, host = ?, username = ?, basic_username = ?`
 	args := []any{name, normalizedHost, username, basicUsername}
@@ -366,6 +554,49 @@ func (s *InstanceStore) Update(ctx context.Context, id int, name, rawHost, usern
 		args = append(args, *tlsSkipVerify)
 	}
 
+	if addDefaults != nil {
+		query += ", default_paused = ?, default_category = ?, default_tags = ?, default_save_path = ?"
+		args = append(args, addDefaults.Paused, addDefaults.Category, addDefaults.Tags, addDefaults.SavePath)
+	}
+
+	if rateLimit != nil {
+		if *rateLimit < 0 {
+			return nil, ErrInvalidRateLimit
+		}
+		query += ", rate_limit = ?"
+		args = append(args, *rateLimit)
+	}
+
+	if disableFuzzySearch != nil {
+		query += ", disable_fuzzy_search = ?"
+		args = append(args, *disableFuzzySearch)
+	}
+
+	if proxy != nil {
+		if err := proxy.Validate(); err != nil {
+			return nil, err
+		}
+		stored := *proxy
+		if !stored.Enabled() {
+			stored = InstanceProxy{}
+		}
+		query += ", proxy_type = ?, proxy_host = ?, proxy_port = ?, proxy_username = ?"
+		args = append(args, stored.Type, stored.Host, stored.Port, stored.Username)
+
+		// A redacted password keeps the stored one; an empty password clears it
+		switch {
+		case stored.Password == "":
+			query += ", proxy_password_encrypted = NULL"
+		case !domain.IsRedactedString(stored.Password):
+			encryptedProxyPassword, err := s.encrypt(stored.Password)
+			if err != nil {
+				return nil, fmt.Errorf("failed to encrypt proxy password: %w", err)
+			}
+			query += ", proxy_password_encrypted = ?"
+			args = append(args, encryptedProxyPassword)
+		}
+	}
+
 	query += " WHERE id = ?"
 	args = append(args, id)
 
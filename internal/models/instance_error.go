@@ -19,11 +19,37 @@ const (
 	ErrorTypeAPI            = "api"
 )
 
+// Error categories that map a failure to what the user should do about it
+const (
+	ErrorCategoryAuthFailure       = "auth_failure"
+	ErrorCategoryTLS               = "tls_error"
+	ErrorCategoryConnectionRefused = "connection_refused"
+	ErrorCategoryHostNotFound      = "host_not_found"
+	ErrorCategoryTimeout           = "timeout"
+	ErrorCategoryDecryption        = "decryption_error"
+	ErrorCategoryBanned            = "banned"
+	ErrorCategoryUnknown           = "unknown"
+)
+
+// errorGuidance is the actionable hint shown for each error category
+var errorGuidance = map[string]string{
+	ErrorCategoryAuthFailure:       "Check the instance username and password.",
+	ErrorCategoryTLS:               "The TLS certificate could not be verified. Fix the certificate or enable TLS skip verify for this instance.",
+	ErrorCategoryConnectionRefused: "qBittorrent is not accepting connections. Check that it is running and the WebUI port is correct.",
+	ErrorCategoryHostNotFound:      "The host name could not be resolved. Check the instance host.",
+	ErrorCategoryTimeout:           "qBittorrent did not respond in time. The instance may be offline or overloaded.",
+	ErrorCategoryDecryption:        "The stored password can no longer be decrypted, usually after the session secret changed. Re-enter the password.",
+	ErrorCategoryBanned:            "qBittorrent banned this IP after failed logins. Wait for the ban to expire or unban it in qBittorrent, then check the credentials.",
+	ErrorCategoryUnknown:           "",
+}
+
 type InstanceError struct {
 	ID           int       `json:"id"`
 	InstanceID   int       `json:"instanceId"`
 	ErrorType    string    `json:"errorType"`
 	ErrorMessage string    `json:"errorMessage"`
+	Category     string    `json:"category"`
+	Guidance     string    `json:"guidance,omitempty"`
 	OccurredAt   time.Time `json:"occurredAt"`
 }
 
@@ -89,6 +115,8 @@ func (s *InstanceErrorStore) GetRecentErrors(ctx context.Context, instanceID int
 		if err := rows.Scan(&e.ID, &e.InstanceID, &e.ErrorType, &e.ErrorMessage, &e.OccurredAt); err != nil {
 			return nil, err
 		}
+		e.Category = ClassifyError(e.ErrorType, e.ErrorMessage)
+		e.Guidance = errorGuidance[e.Category]
 		errors = append(errors, e)
 	}
 	return errors, rows.Err()
@@ -140,3 +168,32 @@ func categorizeError(err error) string {
 	// Default to API error for everything else
 	return ErrorTypeAPI
 }
+
+// ClassifyError maps a recorded error to a category the UI can give guidance for. The stored
+// error type is coarse, so the message is inspected for the specific cause.
+func ClassifyError(errorType, message string) string {
+	msg := strings.ToLower(message)
+
+	switch {
+	case strings.Contains(msg, "decrypt"):
+		return ErrorCategoryDecryption
+	case strings.Contains(msg, "x509") ||
+		strings.Contains(msg, "tls:") ||
+		strings.Contains(msg, "certificate"):
+		return ErrorCategoryTLS
+	case errorType == ErrorTypeBan:
+		return ErrorCategoryBanned
+	case strings.Contains(msg, "timeout") ||
+		strings.Contains(msg, "deadline exceeded") ||
+		strings.Contains(msg, "timed out"):
+		return ErrorCategoryTimeout
+	case strings.Contains(msg, "connection refused"):
+		return ErrorCategoryConnectionRefused
+	case strings.Contains(msg, "no such host"):
+		return ErrorCategoryHostNotFound
+	case errorType == ErrorTypeAuthentication:
+		return ErrorCategoryAuthFailure
+	default:
+		return ErrorCategoryUnknown
+	}
+}
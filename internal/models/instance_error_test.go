@@ -0,0 +1,33 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassifyError(t *testing.T) {
+	tests := []struct {
+		errorType string
+		message   string
+		expected  string
+	}{
+		{ErrorTypeAuthentication, "failed to connect to qBittorrent instance: login error: 401 unauthorized", ErrorCategoryAuthFailure},
+		{ErrorTypeAPI, "failed to decrypt password: cipher: message authentication failed", ErrorCategoryDecryption},
+		{ErrorTypeConnection, `Get "https://qbt.local/api/v2/app/webapiVersion": tls: failed to verify certificate: x509: certificate signed by unknown authority`, ErrorCategoryTLS},
+		{ErrorTypeConnection, "dial tcp 10.0.0.2:8080: connect: connection refused", ErrorCategoryConnectionRefused},
+		{ErrorTypeConnection, "dial tcp: lookup qbt.invalid: no such host", ErrorCategoryHostNotFound},
+		{ErrorTypeConnection, "dial tcp 10.0.0.2:8080: i/o timeout", ErrorCategoryTimeout},
+		{ErrorTypeBan, "User's IP is banned for too many failed login attempts", ErrorCategoryBanned},
+		{ErrorTypeAPI, "unexpected status 500", ErrorCategoryUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ClassifyError(tt.errorType, tt.message))
+		})
+	}
+}
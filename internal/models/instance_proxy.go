@@ -0,0 +1,114 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package models
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/autobrr/qui/internal/domain"
+)
+
+var ErrInvalidProxy = errors.New("invalid proxy")
+
+// ProxyTypes are the supported proxy schemes
+var ProxyTypes = []string{"http", "https", "socks5"}
+
+// InstanceProxy routes all requests to an instance through an HTTP or SOCKS5 proxy.
+// An empty Type means the instance is reached directly.
+type InstanceProxy struct {
+	Type     string `json:"type"`
+	Host     string `json:"host"`
+	Port     int    `json:"port"`
+	Username string `json:"username,omitempty"`
+	// Password is only set in requests and redacted in responses; it is stored encrypted
+	Password string `json:"password,omitempty"`
+}
+
+// Enabled reports whether a proxy is configured
+func (p InstanceProxy) Enabled() bool {
+	return p.Type != ""
+}
+
+// Validate checks the proxy type, host and port. A disabled proxy is always valid.
+func (p InstanceProxy) Validate() error {
+	if !p.Enabled() {
+		return nil
+	}
+	if !slices.Contains(ProxyTypes, p.Type) {
+		return fmt.Errorf("%w: type must be one of %v", ErrInvalidProxy, ProxyTypes)
+	}
+	if p.Host == "" {
+		return fmt.Errorf("%w: host is required", ErrInvalidProxy)
+	}
+	// The host is a bare hostname or IP; schemes, paths and credentials have their own fields
+	if strings.ContainsAny(p.Host, "/@? ") {
+		return fmt.Errorf("%w: host must be a hostname or IP address", ErrInvalidProxy)
+	}
+	if p.Port < 1 || p.Port > 65535 {
+		return fmt.Errorf("%w: port must be between 1 and 65535", ErrInvalidProxy)
+	}
+	if p.Password != "" && p.Username == "" {
+		return fmt.Errorf("%w: password requires a username", ErrInvalidProxy)
+	}
+	return nil
+}
+
+// URL returns the proxy URL with the given plaintext password, or nil when disabled
+func (p InstanceProxy) URL(password string) *url.URL {
+	if !p.Enabled() {
+		return nil
+	}
+
+	u := &url.URL{
+		Scheme: p.Type,
+		Host:   net.JoinHostPort(p.Host, strconv.Itoa(p.Port)),
+	}
+	if p.Username != "" {
+		if password != "" {
+			u.User = url.UserPassword(p.Username, password)
+		} else {
+			u.User = url.User(p.Username)
+		}
+	}
+	return u
+}
+
+// redacted returns the proxy as exposed in responses, with the password redacted if one is stored
+func (p InstanceProxy) redacted(passwordEncrypted *string) InstanceProxy {
+	p.Password = ""
+	if passwordEncrypted != nil {
+		p.Password = domain.RedactString(*passwordEncrypted)
+	}
+	return p
+}
+
+// RedactedProxy returns the instance's proxy settings with the password redacted
+func (i *Instance) RedactedProxy() InstanceProxy {
+	return i.Proxy.redacted(i.ProxyPasswordEncrypted)
+}
+
+// GetProxyURL returns the URL requests to the instance are proxied through, including the
+// decrypted password, or nil when no proxy is configured
+func (s *InstanceStore) GetProxyURL(instance *Instance) (*url.URL, error) {
+	if !instance.Proxy.Enabled() {
+		return nil, nil
+	}
+
+	var password string
+	if instance.ProxyPasswordEncrypted != nil {
+		decrypted, err := s.decrypt(*instance.ProxyPasswordEncrypted)
+		if err != nil {
+			return nil, err
+		}
+		password = decrypted
+	}
+
+	return instance.Proxy.URL(password), nil
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	// MinRefreshInterval is the shortest refresh interval that can be set, so clients never
+	// poll faster than instances can sync
+	MinRefreshInterval = time.Second
+	// MaxRefreshInterval is the longest refresh interval that can be set
+	MaxRefreshInterval = 5 * time.Minute
+)
+
+// ValidateRefreshInterval checks that the interval is zero (follow the sync interval) or
+// within MinRefreshInterval..MaxRefreshInterval
+func ValidateRefreshInterval(interval time.Duration) error {
+	if interval == 0 {
+		return nil
+	}
+	if interval < MinRefreshInterval || interval > MaxRefreshInterval {
+		return fmt.Errorf("refresh interval must be 0 or between %s and %s", MinRefreshInterval, MaxRefreshInterval)
+	}
+	return nil
+}
+
+type RefreshSettingsStore struct {
+	db *sql.DB
+}
+
+func NewRefreshSettingsStore(db *sql.DB) *RefreshSettingsStore {
+	return &RefreshSettingsStore{db: db}
+}
+
+// GetInterval returns the stored refresh interval, or zero if none was saved
+func (s *RefreshSettingsStore) GetInterval(ctx context.Context) (time.Duration, error) {
+	query := `SELECT interval_ms FROM refresh_settings WHERE id = 1`
+
+	var intervalMs int64
+	err := s.db.QueryRowContext(ctx, query).Scan(&intervalMs)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return time.Duration(intervalMs) * time.Millisecond, nil
+}
+
+// UpdateInterval validates and stores the refresh interval
+func (s *RefreshSettingsStore) UpdateInterval(ctx context.Context, interval time.Duration) error {
+	if err := ValidateRefreshInterval(interval); err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO refresh_settings (id, interval_ms)
+		VALUES (1, ?)
+		ON CONFLICT(id) DO UPDATE SET
+			interval_ms = excluded.interval_ms,
+			updated_at = CURRENT_TIMESTAMP
+	`
+
+	_, err := s.db.ExecContext(ctx, query, interval.Milliseconds())
+	return err
+}
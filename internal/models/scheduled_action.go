@@ -0,0 +1,150 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var ErrScheduledActionNotFound = errors.New("scheduled action not found")
+
+// ErrInvalidScheduledAction is returned for an action torrents can't be scheduled for
+var ErrInvalidScheduledAction = errors.New("invalid scheduled action")
+
+// Actions torrents can be scheduled for
+const (
+	ScheduledActionResume = "resume"
+	ScheduledActionStop   = "stop"
+)
+
+// ValidateScheduledAction checks that torrents can be scheduled for action
+func ValidateScheduledAction(action string) error {
+	switch action {
+	case ScheduledActionResume, ScheduledActionStop:
+		return nil
+	default:
+		return fmt.Errorf("%w: %q", ErrInvalidScheduledAction, action)
+	}
+}
+
+// ScheduledAction is a torrent that will be resumed or stopped automatically at RunAt
+type ScheduledAction struct {
+	ID         int       `json:"id"`
+	InstanceID int       `json:"instanceId"`
+	Hash       string    `json:"hash"`
+	Action     string    `json:"action"`
+	RunAt      time.Time `json:"runAt"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+type ScheduledActionStore struct {
+	db *sql.DB
+}
+
+func NewScheduledActionStore(db *sql.DB) *ScheduledActionStore {
+	return &ScheduledActionStore{db: db}
+}
+
+// Upsert schedules an action for a torrent at the given time, replacing any existing schedule of
+// that action for it
+func (s *ScheduledActionStore) Upsert(ctx context.Context, instanceID int, hash, action string, runAt time.Time) (*ScheduledAction, error) {
+	if err := ValidateScheduledAction(action); err != nil {
+		return nil, err
+	}
+
+	query := `
+		INSERT INTO scheduled_actions (instance_id, hash, action, run_at)
+		VALUES (?, ?, ?, ?)
+		ON CONFLICT(instance_id, hash, action) DO UPDATE SET run_at = excluded.run_at
+		RETURNING id, instance_id, hash, action, run_at, created_at
+	`
+
+	var schedule ScheduledAction
+	err := s.db.QueryRowContext(ctx, query, instanceID, hash, action, runAt.UTC().Truncate(time.Second)).Scan(
+		&schedule.ID,
+		&schedule.InstanceID,
+		&schedule.Hash,
+		&schedule.Action,
+		&schedule.RunAt,
+		&schedule.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &schedule, nil
+}
+
+// List returns the scheduled actions of an instance, or of all instances when instanceID is 0,
+// soonest first. An empty action lists every action.
+func (s *ScheduledActionStore) List(ctx context.Context, instanceID int, action string) ([]ScheduledAction, error) {
+	query := `SELECT id, instance_id, hash, action, run_at, created_at FROM scheduled_actions WHERE 1 = 1`
+	var args []any
+	if instanceID != 0 {
+		query += ` AND instance_id = ?`
+		args = append(args, instanceID)
+	}
+	if action != "" {
+		query += ` AND action = ?`
+		args = append(args, action)
+	}
+	query += ` ORDER BY run_at ASC`
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	schedules := []ScheduledAction{}
+	for rows.Next() {
+		var schedule ScheduledAction
+		if err := rows.Scan(&schedule.ID, &schedule.InstanceID, &schedule.Hash, &schedule.Action, &schedule.RunAt, &schedule.CreatedAt); err != nil {
+			return nil, err
+		}
+		schedules = append(schedules, schedule)
+	}
+	return schedules, rows.Err()
+}
+
+// Delete removes the scheduled action of a torrent
+func (s *ScheduledActionStore) Delete(ctx context.Context, instanceID int, hash, action string) error {
+	query := `DELETE FROM scheduled_actions WHERE instance_id = ? AND hash = ? AND action = ?`
+
+	result, err := s.db.ExecContext(ctx, query, instanceID, hash, action)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return ErrScheduledActionNotFound
+	}
+	return nil
+}
+
+// DeleteIfUnchanged removes the scheduled action of a torrent only if it is still set for runAt,
+// so a reschedule made while the action was being handled is kept. It reports whether the
+// schedule was removed.
+func (s *ScheduledActionStore) DeleteIfUnchanged(ctx context.Context, instanceID int, hash, action string, runAt time.Time) (bool, error) {
+	query := `DELETE FROM scheduled_actions WHERE instance_id = ? AND hash = ? AND action = ? AND run_at = ?`
+
+	result, err := s.db.ExecContext(ctx, query, instanceID, hash, action, runAt.UTC().Truncate(time.Second))
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	_ "modernc.org/sqlite"
+)
+
+func newScheduledActionTestStore(t *testing.T) *ScheduledActionStore {
+	t.Helper()
+
+	db, err := sql.Open("sqlite", ":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	_, err = db.Exec(`CREATE TABLE scheduled_actions (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		instance_id INTEGER NOT NULL,
+		hash TEXT NOT NULL,
+		action TEXT NOT NULL,
+		run_at TIMESTAMP NOT NULL,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		UNIQUE (instance_id, hash, action)
+	)`)
+	require.NoError(t, err)
+
+	return NewScheduledActionStore(db)
+}
+
+func TestScheduledActionStore_Actions(t *testing.T) {
+	ctx := context.Background()
+	store := newScheduledActionTestStore(t)
+
+	// A torrent can be both resumed and stopped later on
+	_, err := store.Upsert(ctx, 1, "abc", ScheduledActionStop, time.Now().Add(2*time.Hour))
+	require.NoError(t, err)
+	_, err = store.Upsert(ctx, 1, "abc", ScheduledActionResume, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	_, err = store.Upsert(ctx, 1, "abc", "delete", time.Now())
+	assert.ErrorIs(t, err, ErrInvalidScheduledAction)
+
+	schedules, err := store.List(ctx, 1, "")
+	require.NoError(t, err)
+	require.Len(t, schedules, 2)
+	assert.Equal(t, ScheduledActionResume, schedules[0].Action, "soonest first")
+
+	stops, err := store.List(ctx, 0, ScheduledActionStop)
+	require.NoError(t, err)
+	require.Len(t, stops, 1)
+
+	require.NoError(t, store.Delete(ctx, 1, "abc", ScheduledActionStop))
+	assert.ErrorIs(t, store.Delete(ctx, 1, "abc", ScheduledActionStop), ErrScheduledActionNotFound)
+}
+
+func TestScheduledActionStore_DeleteIfUnchanged(t *testing.T) {
+	ctx := context.Background()
+	store := newScheduledActionTestStore(t)
+
+	handled, err := store.Upsert(ctx, 1, "abc", ScheduledActionResume, time.Now().Add(-time.Minute))
+	require.NoError(t, err)
+
+	// Rescheduled while the due resume was being applied
+	_, err = store.Upsert(ctx, 1, "abc", ScheduledActionResume, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	removed, err := store.DeleteIfUnchanged(ctx, 1, "abc", ScheduledActionResume, handled.RunAt)
+	require.NoError(t, err)
+	assert.False(t, removed)
+
+	schedules, err := store.List(ctx, 1, ScheduledActionResume)
+	require.NoError(t, err)
+	require.Len(t, schedules, 1)
+
+	removed, err = store.DeleteIfUnchanged(ctx, 1, "abc", ScheduledActionResume, schedules[0].RunAt)
+	require.NoError(t, err)
+	assert.True(t, removed)
+}
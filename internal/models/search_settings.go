@@ -0,0 +1,87 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+// MaxSearchFieldWeight is the highest weight a search field can be given
+const MaxSearchFieldWeight = 10
+
+// SearchWeights sets how important each torrent field is when ranking search matches.
+// Within a match tier, a match on a lower weighted field ranks below the highest weighted
+// field by the difference in weight. Equal weights rank all fields the same.
+type SearchWeights struct {
+	Name     int `json:"name"`
+	Category int `json:"category"`
+	Tags     int `json:"tags"`
+}
+
+// DefaultSearchWeights ranks all fields equally
+func DefaultSearchWeights() SearchWeights {
+	return SearchWeights{Name: 1, Category: 1, Tags: 1}
+}
+
+// Validate checks that every weight is within 0..MaxSearchFieldWeight
+func (w SearchWeights) Validate() error {
+	fields := []struct {
+		name   string
+		weight int
+	}{{"name", w.Name}, {"category", w.Category}, {"tags", w.Tags}}
+
+	for _, field := range fields {
+		if field.weight < 0 || field.weight > MaxSearchFieldWeight {
+			return fmt.Errorf("%s weight must be between 0 and %d", field.name, MaxSearchFieldWeight)
+		}
+	}
+	return nil
+}
+
+type SearchSettingsStore struct {
+	db *sql.DB
+}
+
+func NewSearchSettingsStore(db *sql.DB) *SearchSettingsStore {
+	return &SearchSettingsStore{db: db}
+}
+
+// GetWeights returns the stored search weights, or the defaults if none were saved
+func (s *SearchSettingsStore) GetWeights(ctx context.Context) (SearchWeights, error) {
+	query := `SELECT name_weight, category_weight, tags_weight FROM search_settings WHERE id = 1`
+
+	var weights SearchWeights
+	err := s.db.QueryRowContext(ctx, query).Scan(&weights.Name, &weights.Category, &weights.Tags)
+	if errors.Is(err, sql.ErrNoRows) {
+		return DefaultSearchWeights(), nil
+	}
+	if err != nil {
+		return SearchWeights{}, err
+	}
+
+	return weights, nil
+}
+
+// UpdateWeights validates and stores the search weights
+func (s *SearchSettingsStore) UpdateWeights(ctx context.Context, weights SearchWeights) error {
+	if err := weights.Validate(); err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO search_settings (id, name_weight, category_weight, tags_weight)
+		VALUES (1, ?, ?, ?)
+		ON CONFLICT(id) DO UPDATE SET
+			name_weight = excluded.name_weight,
+			category_weight = excluded.category_weight,
+			tags_weight = excluded.tags_weight,
+			updated_at = CURRENT_TIMESTAMP
+	`
+
+	_, err := s.db.ExecContext(ctx, query, weights.Name, weights.Category, weights.Tags)
+	return err
+}
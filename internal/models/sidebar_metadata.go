@@ -0,0 +1,136 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ErrInvalidSidebarMetadata is returned for sidebar metadata that can't be stored
+var ErrInvalidSidebarMetadata = errors.New("invalid sidebar metadata")
+
+// ErrSidebarMetadataNotFound is returned when deleting metadata that was never set
+var ErrSidebarMetadataNotFound = errors.New("sidebar metadata not found")
+
+// Kinds of sidebar items metadata can be set for
+const (
+	SidebarKindCategory = "category"
+	SidebarKindTag      = "tag"
+)
+
+var hexColorPattern = regexp.MustCompile(`^#(?:[0-9a-f]{3}|[0-9a-f]{6})$`)
+
+// SidebarItemMetadata is how qui shows a category or tag in the sidebar. Both fields are
+// optional; items without an order sort after ordered ones.
+type SidebarItemMetadata struct {
+	Color *string `json:"color,omitempty"` // Hex color, #rgb or #rrggbb
+	Order *int    `json:"order,omitempty"`
+}
+
+// SidebarMetadata holds the metadata of an instance's categories and tags by name
+type SidebarMetadata struct {
+	Categories map[string]SidebarItemMetadata `json:"categories"`
+	Tags       map[string]SidebarItemMetadata `json:"tags"`
+}
+
+// normalizeSidebarItem validates the kind, name and color of a sidebar item and lowercases the color
+func normalizeSidebarItem(kind, name string, metadata SidebarItemMetadata) (SidebarItemMetadata, error) {
+	if kind != SidebarKindCategory && kind != SidebarKindTag {
+		return metadata, fmt.Errorf("%w: kind must be %q or %q", ErrInvalidSidebarMetadata, SidebarKindCategory, SidebarKindTag)
+	}
+	// Uncategorized torrents have the empty category, but every tag has a name
+	if kind == SidebarKindTag && name == "" {
+		return metadata, fmt.Errorf("%w: tag name is required", ErrInvalidSidebarMetadata)
+	}
+	if metadata.Color != nil {
+		color := strings.ToLower(strings.TrimSpace(*metadata.Color))
+		if !hexColorPattern.MatchString(color) {
+			return metadata, fmt.Errorf("%w: color %q is not a hex color like #1e90ff", ErrInvalidSidebarMetadata, *metadata.Color)
+		}
+		metadata.Color = &color
+	}
+	return metadata, nil
+}
+
+type SidebarMetadataStore struct {
+	db *sql.DB
+}
+
+func NewSidebarMetadataStore(db *sql.DB) *SidebarMetadataStore {
+	return &SidebarMetadataStore{db: db}
+}
+
+// Get returns the metadata of all categories and tags of an instance
+func (s *SidebarMetadataStore) Get(ctx context.Context, instanceID int) (*SidebarMetadata, error) {
+	query := `SELECT kind, name, color, display_order FROM sidebar_metadata WHERE instance_id = ?`
+
+	rows, err := s.db.QueryContext(ctx, query, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	metadata := &SidebarMetadata{
+		Categories: map[string]SidebarItemMetadata{},
+		Tags:       map[string]SidebarItemMetadata{},
+	}
+	for rows.Next() {
+		var kind, name string
+		var item SidebarItemMetadata
+		if err := rows.Scan(&kind, &name, &item.Color, &item.Order); err != nil {
+			return nil, err
+		}
+		if kind == SidebarKindTag {
+			metadata.Tags[name] = item
+		} else {
+			metadata.Categories[name] = item
+		}
+	}
+
+	return metadata, rows.Err()
+}
+
+// Set validates and stores the metadata of a category or tag, replacing what was set before
+func (s *SidebarMetadataStore) Set(ctx context.Context, instanceID int, kind, name string, metadata SidebarItemMetadata) (SidebarItemMetadata, error) {
+	metadata, err := normalizeSidebarItem(kind, name, metadata)
+	if err != nil {
+		return metadata, err
+	}
+
+	query := `
+		INSERT INTO sidebar_metadata (instance_id, kind, name, color, display_order)
+		VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT(instance_id, kind, name) DO UPDATE SET
+			color = excluded.color,
+			display_order = excluded.display_order,
+			updated_at = CURRENT_TIMESTAMP
+	`
+
+	_, err = s.db.ExecContext(ctx, query, instanceID, kind, name, metadata.Color, metadata.Order)
+	return metadata, err
+}
+
+// Delete removes the metadata of a category or tag
+func (s *SidebarMetadataStore) Delete(ctx context.Context, instanceID int, kind, name string) error {
+	query := `DELETE FROM sidebar_metadata WHERE instance_id = ? AND kind = ? AND name = ?`
+
+	result, err := s.db.ExecContext(ctx, query, instanceID, kind, name)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return ErrSidebarMetadataNotFound
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeSidebarItem(t *testing.T) {
+	color := " #1E90FF "
+	order := 2
+	item, err := normalizeSidebarItem(SidebarKindCategory, "movies", SidebarItemMetadata{Color: &color, Order: &order})
+	require.NoError(t, err)
+	require.NotNil(t, item.Color)
+	assert.Equal(t, "#1e90ff", *item.Color)
+	assert.Equal(t, 2, *item.Order)
+
+	short := "#abc"
+	_, err = normalizeSidebarItem(SidebarKindTag, "hd", SidebarItemMetadata{Color: &short})
+	assert.NoError(t, err)
+
+	// Uncategorized torrents can be colored too
+	_, err = normalizeSidebarItem(SidebarKindCategory, "", SidebarItemMetadata{Order: &order})
+	assert.NoError(t, err)
+
+	for _, invalid := range []string{"red", "#12345", "1e90ff", "#ggg"} {
+		_, err := normalizeSidebarItem(SidebarKindTag, "hd", SidebarItemMetadata{Color: &invalid})
+		assert.ErrorIs(t, err, ErrInvalidSidebarMetadata, invalid)
+	}
+
+	_, err = normalizeSidebarItem("tracker", "example", SidebarItemMetadata{})
+	assert.ErrorIs(t, err, ErrInvalidSidebarMetadata)
+	_, err = normalizeSidebarItem(SidebarKindTag, "", SidebarItemMetadata{})
+	assert.ErrorIs(t, err, ErrInvalidSidebarMetadata)
+}
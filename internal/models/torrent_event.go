@@ -0,0 +1,124 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// Torrent event types
+const (
+	TorrentEventAdded     = "added"
+	TorrentEventStarted   = "started"
+	TorrentEventPaused    = "paused"
+	TorrentEventCompleted = "completed"
+	TorrentEventErrored   = "errored"
+)
+
+// TorrentEvent is a notable state transition of a torrent
+type TorrentEvent struct {
+	ID         int       `json:"id"`
+	InstanceID int       `json:"instanceId"`
+	Hash       string    `json:"hash"`
+	Event      string    `json:"event"`
+	State      string    `json:"state,omitempty"`
+	OccurredAt time.Time `json:"occurredAt"`
+}
+
+type TorrentEventStore struct {
+	db *sql.DB
+}
+
+func NewTorrentEventStore(db *sql.DB) *TorrentEventStore {
+	return &TorrentEventStore{db: db}
+}
+
+// Insert records events in a single transaction
+func (s *TorrentEventStore) Insert(ctx context.Context, events []TorrentEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.PrepareContext(ctx, `INSERT INTO torrent_events (instance_id, hash, event, state, occurred_at) VALUES (?, ?, ?, ?, ?)`)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for _, event := range events {
+		if _, err := stmt.ExecContext(ctx, event.InstanceID, event.Hash, event.Event, event.State, event.OccurredAt.UTC().Truncate(time.Second)); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// ListForTorrent returns the events of a torrent, oldest first
+func (s *TorrentEventStore) ListForTorrent(ctx context.Context, instanceID int, hash string) ([]TorrentEvent, error) {
+	query := `
+		SELECT id, instance_id, hash, event, state, occurred_at
+		FROM torrent_events
+		WHERE instance_id = ? AND hash = ?
+		ORDER BY occurred_at ASC, id ASC
+	`
+
+	rows, err := s.db.QueryContext(ctx, query, instanceID, hash)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	events := []TorrentEvent{}
+	for rows.Next() {
+		var event TorrentEvent
+		if err := rows.Scan(&event.ID, &event.InstanceID, &event.Hash, &event.Event, &event.State, &event.OccurredAt); err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, rows.Err()
+}
+
+// ListHashes returns the hashes of an instance that have events
+func (s *TorrentEventStore) ListHashes(ctx context.Context, instanceID int) ([]string, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT DISTINCT hash FROM torrent_events WHERE instance_id = ?`, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var hashes []string
+	for rows.Next() {
+		var hash string
+		if err := rows.Scan(&hash); err != nil {
+			return nil, err
+		}
+		hashes = append(hashes, hash)
+	}
+	return hashes, rows.Err()
+}
+
+// DeleteForTorrent removes all events of a torrent
+func (s *TorrentEventStore) DeleteForTorrent(ctx context.Context, instanceID int, hash string) error {
+	_, err := s.db.ExecContext(ctx, `DELETE FROM torrent_events WHERE instance_id = ? AND hash = ?`, instanceID, hash)
+	return err
+}
+
+// DeleteOlderThan removes events that occurred before the cutoff and returns how many were removed
+func (s *TorrentEventStore) DeleteOlderThan(ctx context.Context, cutoff time.Time) (int64, error) {
+	result, err := s.db.ExecContext(ctx, `DELETE FROM torrent_events WHERE occurred_at < ?`, cutoff.UTC())
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
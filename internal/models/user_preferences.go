@@ -0,0 +1,80 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// MaxUserPreferencesSize caps the stored preferences document in bytes
+const MaxUserPreferencesSize = 64 << 10
+
+// ErrInvalidPreferences is returned when preferences are not a JSON object or too large
+var ErrInvalidPreferences = errors.New("invalid preferences")
+
+// UserPreferencesStore keeps free-form UI preferences per user. The server doesn't interpret
+// them; the frontend owns the keys.
+type UserPreferencesStore struct {
+	db *sql.DB
+}
+
+func NewUserPreferencesStore(db *sql.DB) *UserPreferencesStore {
+	return &UserPreferencesStore{db: db}
+}
+
+// Get returns the preferences of a user, or an empty object if none were saved
+func (s *UserPreferencesStore) Get(ctx context.Context, userID int) (json.RawMessage, error) {
+	var raw string
+	err := s.db.QueryRowContext(ctx, `SELECT preferences FROM user_preferences WHERE user_id = ?`, userID).Scan(&raw)
+	if errors.Is(err, sql.ErrNoRows) {
+		return json.RawMessage(`{}`), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return json.RawMessage(raw), nil
+}
+
+// Update validates and replaces the preferences of a user
+func (s *UserPreferencesStore) Update(ctx context.Context, userID int, preferences json.RawMessage) error {
+	if err := ValidatePreferences(preferences); err != nil {
+		return err
+	}
+
+	// Store compacted so whitespace doesn't count against the size cap on the next read
+	var compact bytes.Buffer
+	if err := json.Compact(&compact, preferences); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidPreferences, err)
+	}
+
+	query := `
+		INSERT INTO user_preferences (user_id, preferences)
+		VALUES (?, ?)
+		ON CONFLICT(user_id) DO UPDATE SET
+			preferences = excluded.preferences,
+			updated_at = CURRENT_TIMESTAMP
+	`
+
+	_, err := s.db.ExecContext(ctx, query, userID, compact.String())
+	return err
+}
+
+// ValidatePreferences checks that preferences are a JSON object within MaxUserPreferencesSize
+func ValidatePreferences(preferences json.RawMessage) error {
+	if len(preferences) > MaxUserPreferencesSize {
+		return fmt.Errorf("%w: must be at most %d bytes", ErrInvalidPreferences, MaxUserPreferencesSize)
+	}
+
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(preferences, &object); err != nil || object == nil {
+		return fmt.Errorf("%w: must be a JSON object", ErrInvalidPreferences)
+	}
+	return nil
+}
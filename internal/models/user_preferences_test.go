@@ -0,0 +1,24 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package models
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatePreferences(t *testing.T) {
+	assert.NoError(t, ValidatePreferences(json.RawMessage(`{}`)))
+	assert.NoError(t, ValidatePreferences(json.RawMessage(`{"pageSize": 100, "columns": ["name", "size"]}`)))
+
+	for _, invalid := range []string{`[]`, `"text"`, `null`, `42`, `{`, ``} {
+		assert.ErrorIs(t, ValidatePreferences(json.RawMessage(invalid)), ErrInvalidPreferences, invalid)
+	}
+
+	tooLarge := `{"value": "` + strings.Repeat("x", MaxUserPreferencesSize) + `"}`
+	assert.ErrorIs(t, ValidatePreferences(json.RawMessage(tooLarge)), ErrInvalidPreferences)
+}
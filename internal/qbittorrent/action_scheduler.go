@@ -0,0 +1,182 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/autobrr/qui/internal/models"
+)
+
+const (
+	// actionRetryDelay is how long a failed scheduled action waits before it is retried
+	actionRetryDelay = time.Minute
+	// actionGiveUpAfter drops schedules that still fail this long after they were due,
+	// e.g. because the torrent was deleted
+	actionGiveUpAfter = 24 * time.Hour
+	// actionIdleCheck bounds how long the worker sleeps when nothing is scheduled
+	actionIdleCheck = time.Hour
+)
+
+// scheduledBulkActions maps scheduled actions to the bulk action that applies them
+var scheduledBulkActions = map[string]string{
+	models.ScheduledActionResume: "resume",
+	models.ScheduledActionStop:   "pause",
+}
+
+// ActionScheduler resumes or stops torrents at a scheduled time, e.g. to stop seeding at a set
+// moment rather than at a share limit. Schedules are persisted so they survive restarts;
+// schedules that fell due while qui was down run on startup.
+type ActionScheduler struct {
+	store       *models.ScheduledActionStore
+	syncManager *SyncManager
+	wake        chan struct{}
+}
+
+func NewActionScheduler(store *models.ScheduledActionStore, syncManager *SyncManager) *ActionScheduler {
+	return &ActionScheduler{
+		store:       store,
+		syncManager: syncManager,
+		wake:        make(chan struct{}, 1),
+	}
+}
+
+// Schedule runs the action on the torrent at the given time, replacing any earlier schedule of
+// that action for it
+func (s *ActionScheduler) Schedule(ctx context.Context, instanceID int, hash, action string, at time.Time) (*models.ScheduledAction, error) {
+	if hash == "" {
+		return nil, fmt.Errorf("torrent hash is required")
+	}
+
+	schedule, err := s.store.Upsert(ctx, instanceID, hash, action, at)
+	if err != nil {
+		return nil, fmt.Errorf("failed to store scheduled %s: %w", action, err)
+	}
+
+	s.notify()
+	return schedule, nil
+}
+
+// ScheduleResume resumes the torrent at the given time
+func (s *ActionScheduler) ScheduleResume(ctx context.Context, instanceID int, hash string, at time.Time) (*models.ScheduledAction, error) {
+	return s.Schedule(ctx, instanceID, hash, models.ScheduledActionResume, at)
+}
+
+// ScheduleStop stops seeding the torrent at the given time
+func (s *ActionScheduler) ScheduleStop(ctx context.Context, instanceID int, hash string, at time.Time) (*models.ScheduledAction, error) {
+	return s.Schedule(ctx, instanceID, hash, models.ScheduledActionStop, at)
+}
+
+// Cancel removes the scheduled action of a torrent
+func (s *ActionScheduler) Cancel(ctx context.Context, instanceID int, hash, action string) error {
+	if err := s.store.Delete(ctx, instanceID, hash, action); err != nil {
+		return err
+	}
+
+	s.notify()
+	return nil
+}
+
+// ListScheduled returns the pending actions of an instance, soonest first. An empty action
+// lists every action.
+func (s *ActionScheduler) ListScheduled(ctx context.Context, instanceID int, action string) ([]models.ScheduledAction, error) {
+	return s.store.List(ctx, instanceID, action)
+}
+
+// Start runs the worker until ctx is cancelled
+func (s *ActionScheduler) Start(ctx context.Context) {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.wake:
+			if !timer.Stop() {
+				select {
+				case <-timer.C:
+				default:
+				}
+			}
+			timer.Reset(0)
+		case <-timer.C:
+			timer.Reset(s.runDue(ctx, time.Now()))
+		}
+	}
+}
+
+func (s *ActionScheduler) notify() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// runDue applies every action that is due and returns the delay until the next check
+func (s *ActionScheduler) runDue(ctx context.Context, now time.Time) time.Duration {
+	schedules, err := s.store.List(ctx, 0, "")
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to load scheduled actions")
+		return actionRetryDelay
+	}
+
+	due, next := splitDueActions(schedules, now)
+
+	for _, schedule := range due {
+		if !s.apply(ctx, now, schedule) {
+			next = min(next, actionRetryDelay)
+			continue
+		}
+
+		// The torrent may have been rescheduled while the action was applied; keep the new schedule
+		if _, err := s.store.DeleteIfUnchanged(ctx, schedule.InstanceID, schedule.Hash, schedule.Action, schedule.RunAt); err != nil {
+			log.Error().Err(err).Int("instanceID", schedule.InstanceID).Str("hash", schedule.Hash).Str("action", schedule.Action).Msg("Failed to remove scheduled action")
+		}
+	}
+
+	return next
+}
+
+// apply runs the bulk action of a due schedule. It returns false when the action failed and
+// should be retried; schedules failing past actionGiveUpAfter are given up on.
+func (s *ActionScheduler) apply(ctx context.Context, now time.Time, schedule models.ScheduledAction) bool {
+	logger := log.With().Int("instanceID", schedule.InstanceID).Str("hash", schedule.Hash).Str("action", schedule.Action).Logger()
+
+	bulkAction, ok := scheduledBulkActions[schedule.Action]
+	if !ok {
+		logger.Error().Msg("Dropping schedule of unknown action")
+		return true
+	}
+
+	if err := s.syncManager.BulkAction(ctx, schedule.InstanceID, []string{schedule.Hash}, bulkAction); err != nil {
+		if now.Sub(schedule.RunAt) < actionGiveUpAfter {
+			logger.Warn().Err(err).Msg("Scheduled action failed, will retry")
+			return false
+		}
+		logger.Error().Err(err).Msg("Giving up on scheduled action")
+		return true
+	}
+
+	logger.Info().Msg("Applied scheduled action to torrent")
+	return true
+}
+
+// splitDueActions returns the schedules due at now and the delay until the next pending one
+func splitDueActions(schedules []models.ScheduledAction, now time.Time) ([]models.ScheduledAction, time.Duration) {
+	next := actionIdleCheck
+	var due []models.ScheduledAction
+	for _, schedule := range schedules {
+		if !schedule.RunAt.After(now) {
+			due = append(due, schedule)
+			continue
+		}
+		next = min(next, schedule.RunAt.Sub(now))
+	}
+	return due, next
+}
@@ -0,0 +1,34 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/autobrr/qui/internal/models"
+)
+
+func TestSplitDueActions(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	schedules := []models.ScheduledAction{
+		{Hash: "overdue", Action: models.ScheduledActionResume, RunAt: now.Add(-time.Hour)},
+		{Hash: "freeleech-ended", Action: models.ScheduledActionStop, RunAt: now},
+		{Hash: "soon", Action: models.ScheduledActionResume, RunAt: now.Add(5 * time.Minute)},
+		{Hash: "tonight", Action: models.ScheduledActionStop, RunAt: now.Add(8 * time.Hour)},
+	}
+
+	due, next := splitDueActions(schedules, now)
+	assert.Len(t, due, 2)
+	assert.Equal(t, "overdue", due[0].Hash)
+	assert.Equal(t, "freeleech-ended", due[1].Hash)
+	assert.Equal(t, 5*time.Minute, next)
+
+	due, next = splitDueActions(nil, now)
+	assert.Empty(t, due)
+	assert.Equal(t, actionIdleCheck, next)
+}
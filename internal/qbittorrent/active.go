@@ -0,0 +1,36 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"slices"
+	"time"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+)
+
+// SetActiveWindow sets what counts as an active torrent in status filters, counts and stats.
+// With a positive window a torrent is active while it transfers data and for the window after
+// its last transfer. Otherwise only the downloading, uploading and forced states are active.
+func (sm *SyncManager) SetActiveWindow(window time.Duration) {
+	sm.activeWindow.Store(int64(max(window, 0)))
+}
+
+// isActive reports whether a torrent is active under the configured definition
+func (sm *SyncManager) isActive(torrent qbt.Torrent) bool {
+	return isActiveTorrent(torrent, time.Duration(sm.activeWindow.Load()), time.Now())
+}
+
+// isActiveTorrent decides whether a torrent is active. A zero window uses the torrent's state;
+// a positive one its speeds and when it last transferred data.
+func isActiveTorrent(torrent qbt.Torrent, window time.Duration, now time.Time) bool {
+	if window <= 0 {
+		return slices.Contains(torrentStateCategories[qbt.TorrentFilterActive], torrent.State)
+	}
+	if torrent.DlSpeed > 0 || torrent.UpSpeed > 0 {
+		return true
+	}
+	// qBittorrent reports 0 or -1 for torrents that never transferred
+	return torrent.LastActivity > 0 && now.Sub(time.Unix(torrent.LastActivity, 0)) <= window
+}
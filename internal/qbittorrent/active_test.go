@@ -0,0 +1,75 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"testing"
+	"time"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsActiveTorrent(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	window := 10 * time.Minute
+
+	tests := []struct {
+		name       string
+		torrent    qbt.Torrent
+		stateBased bool
+		windowed   bool
+	}{
+		{
+			name:       "uploading",
+			torrent:    qbt.Torrent{State: qbt.TorrentStateUploading, UpSpeed: 1024, LastActivity: now.Unix()},
+			stateBased: true,
+			windowed:   true,
+		},
+		{
+			name:     "stalled seed that uploaded recently",
+			torrent:  qbt.Torrent{State: qbt.TorrentStateStalledUp, LastActivity: now.Add(-5 * time.Minute).Unix()},
+			windowed: true,
+		},
+		{
+			name:    "stalled seed idle for an hour",
+			torrent: qbt.Torrent{State: qbt.TorrentStateStalledUp, LastActivity: now.Add(-time.Hour).Unix()},
+		},
+		{
+			name:       "forced upload that has gone idle",
+			torrent:    qbt.Torrent{State: qbt.TorrentStateForcedUp, LastActivity: now.Add(-time.Hour).Unix()},
+			stateBased: true,
+		},
+		{
+			name:    "never transferred",
+			torrent: qbt.Torrent{State: qbt.TorrentStateStalledDl, LastActivity: -1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.stateBased, isActiveTorrent(tt.torrent, 0, now), "state-based")
+			assert.Equal(t, tt.windowed, isActiveTorrent(tt.torrent, window, now), "windowed")
+		})
+	}
+}
+
+func TestActiveWindowAppliesToFiltersCountsAndStats(t *testing.T) {
+	sm := &SyncManager{}
+	idleSeed := qbt.Torrent{State: qbt.TorrentStateStalledUp, Progress: 1, LastActivity: time.Now().Add(-2 * time.Minute).Unix()}
+
+	assert.False(t, sm.matchTorrentStatus(idleSeed, string(qbt.TorrentFilterActive)))
+	assert.Zero(t, sm.calculateStats([]qbt.Torrent{idleSeed}).Active)
+
+	sm.SetActiveWindow(5 * time.Minute)
+
+	assert.True(t, sm.matchTorrentStatus(idleSeed, string(qbt.TorrentFilterActive)))
+	assert.False(t, sm.matchTorrentStatus(idleSeed, string(qbt.TorrentFilterInactive)))
+	assert.Equal(t, 1, sm.calculateStats([]qbt.Torrent{idleSeed}).Active)
+
+	counts := map[string]int{}
+	sm.countTorrentStatuses(idleSeed, counts)
+	assert.Equal(t, 1, counts["active"])
+	assert.Zero(t, counts["inactive"])
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+	"github.com/rs/zerolog/log"
+)
+
+// CategoryDef is a category in a category template
+type CategoryDef struct {
+	Name     string `json:"name"`
+	SavePath string `json:"savePath"`
+}
+
+// CategoryTemplateDiff describes what applying a category template changed on one instance
+type CategoryTemplateDiff struct {
+	InstanceID int      `json:"instanceId"`
+	Created    []string `json:"created"`
+	Updated    []string `json:"updated"`
+	Removed    []string `json:"removed"`
+	Error      string   `json:"error,omitempty"`
+}
+
+// ApplyCategoryTemplate converges the categories of each instance to the template: missing
+// categories are created and differing save paths are updated. With removeExtras, categories
+// not in the template are removed as well. Instances are processed independently; a failure on
+// one is reported in its diff and does not stop the others.
+func (sm *SyncManager) ApplyCategoryTemplate(ctx context.Context, instanceIDs []int, categories []CategoryDef, removeExtras bool) ([]CategoryTemplateDiff, error) {
+	for _, category := range categories {
+		if strings.TrimSpace(category.Name) == "" {
+			return nil, fmt.Errorf("category name is required")
+		}
+	}
+
+	diffs := make([]CategoryTemplateDiff, 0, len(instanceIDs))
+	for _, instanceID := range instanceIDs {
+		diff, err := sm.applyCategoryTemplate(ctx, instanceID, categories, removeExtras)
+		if err != nil {
+			log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to apply category template")
+			diff.Error = err.Error()
+		}
+		diffs = append(diffs, diff)
+	}
+
+	return diffs, nil
+}
+
+func (sm *SyncManager) applyCategoryTemplate(ctx context.Context, instanceID int, categories []CategoryDef, removeExtras bool) (CategoryTemplateDiff, error) {
+	diff := CategoryTemplateDiff{
+		InstanceID: instanceID,
+		Created:    []string{},
+		Updated:    []string{},
+		Removed:    []string{},
+	}
+
+	client, syncManager, err := sm.getClientAndSyncManager(ctx, instanceID)
+	if err != nil {
+		return diff, err
+	}
+
+	create, update, remove := planCategoryTemplate(syncManager.GetCategories(), categories, removeExtras)
+
+	for _, category := range create {
+		if err := client.CreateCategoryCtx(ctx, category.Name, category.SavePath); err != nil {
+			return diff, fmt.Errorf("failed to create category %q: %w", category.Name, err)
+		}
+		diff.Created = append(diff.Created, category.Name)
+	}
+
+	for _, category := range update {
+		if err := client.EditCategoryCtx(ctx, category.Name, category.SavePath); err != nil {
+			return diff, fmt.Errorf("failed to update category %q: %w", category.Name, err)
+		}
+		diff.Updated = append(diff.Updated, category.Name)
+	}
+
+	if len(remove) > 0 {
+		if err := client.RemoveCategoriesCtx(ctx, remove); err != nil {
+			return diff, fmt.Errorf("failed to remove categories: %w", err)
+		}
+		diff.Removed = remove
+	}
+
+	if len(create)+len(update)+len(remove) > 0 {
+		sm.syncAfterModification(instanceID, client, "apply_category_template")
+	}
+
+	return diff, nil
+}
+
+// planCategoryTemplate works out which categories to create, update and remove to match the template
+func planCategoryTemplate(existing map[string]qbt.Category, template []CategoryDef, removeExtras bool) (create, update []CategoryDef, remove []string) {
+	inTemplate := make(map[string]struct{}, len(template))
+	for _, category := range template {
+		inTemplate[category.Name] = struct{}{}
+
+		current, exists := existing[category.Name]
+		switch {
+		case !exists:
+			create = append(create, category)
+		case !sameSavePath(current.SavePath, category.SavePath):
+			update = append(update, category)
+		}
+	}
+
+	if removeExtras {
+		for name := range existing {
+			if _, ok := inTemplate[name]; !ok {
+				remove = append(remove, name)
+			}
+		}
+		slices.Sort(remove)
+	}
+
+	return create, update, remove
+}
+
+func sameSavePath(a, b string) bool {
+	if a == "" || b == "" {
+		return a == b
+	}
+	return filepath.Clean(a) == filepath.Clean(b)
+}
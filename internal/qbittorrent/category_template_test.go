@@ -0,0 +1,46 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"testing"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlanCategoryTemplate(t *testing.T) {
+	existing := map[string]qbt.Category{
+		"movies": {Name: "movies", SavePath: "/data/movies/"},
+		"tv":     {Name: "tv", SavePath: "/data/tv"},
+		"old":    {Name: "old", SavePath: "/data/old"},
+		"apps":   {Name: "apps", SavePath: ""},
+	}
+	template := []CategoryDef{
+		{Name: "movies", SavePath: "/data/movies"},
+		{Name: "tv", SavePath: "/data/series"},
+		{Name: "music", SavePath: "/data/music"},
+		{Name: "apps", SavePath: ""},
+	}
+
+	t.Run("keeps extras by default", func(t *testing.T) {
+		create, update, remove := planCategoryTemplate(existing, template, false)
+		assert.Equal(t, []CategoryDef{{Name: "music", SavePath: "/data/music"}}, create)
+		assert.Equal(t, []CategoryDef{{Name: "tv", SavePath: "/data/series"}}, update)
+		assert.Empty(t, remove)
+	})
+
+	t.Run("removes extras when requested", func(t *testing.T) {
+		_, _, remove := planCategoryTemplate(existing, template, true)
+		assert.Equal(t, []string{"old"}, remove)
+	})
+}
+
+func TestValidateCategory(t *testing.T) {
+	categories := map[string]qbt.Category{"movies": {Name: "movies", SavePath: "/data/movies"}}
+
+	assert.NoError(t, validateCategory(categories, "movies"))
+	assert.NoError(t, validateCategory(categories, ""), "clearing the category is always allowed")
+	assert.ErrorIs(t, validateCategory(categories, "tv"), ErrCategoryNotFound)
+}
@@ -6,10 +6,11 @@ package qbittorrent
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/url"
 	"sync"
 	"time"
 
-	"github.com/Masterminds/semver/v3"
 	"github.com/autobrr/autobrr/pkg/ttlcache"
 	qbt "github.com/autobrr/go-qbittorrent"
 	"github.com/pkg/errors"
@@ -19,24 +20,43 @@ import (
 type Client struct {
 	*qbt.Client
 	instanceID      int
+	host            string // Base URL, used for API endpoints not wrapped by go-qbittorrent
+	basicUser       string
+	basicPass       string
 	webAPIVersion   string
-	supportsSetTags bool
+	capabilities    Capabilities
 	lastHealthCheck time.Time
 	isHealthy       bool
+	lastSyncError   string    // Error of the last sync, cleared by the next successful one
+	synced          bool      // Set by the first successful sync; until then the torrent cache is empty
+	lastWarmUp      time.Time // Last sync attempted by warmUp
 	syncManager     *qbt.SyncManager
 	peerSyncManager map[string]*qbt.PeerSyncManager // Map of torrent hash to PeerSyncManager
 	// optimisticUpdates stores temporary optimistic state changes for this instance
 	optimisticUpdates *ttlcache.Cache[string, *OptimisticTorrentUpdate]
 	trackerExclusions map[string]map[string]struct{} // Domains to hide hashes from until fresh sync arrives
+	queueOverlay      *queueOverlay                  // Queue positions of the last move until a sync confirms them
+	privateFlags      *ttlcache.Cache[string, bool]  // Torrent hash to private flag
+	fuzzySearch       bool                           // Whether search falls back to fuzzy name matches
+	syncOptions       qbt.SyncOptions
 	mu                sync.RWMutex
 	healthMu          sync.RWMutex
 }
 
-func NewClient(instanceID int, instanceHost, username, password string, basicUsername, basicPassword *string, tlsSkipVerify bool) (*Client, error) {
-	return NewClientWithTimeout(instanceID, instanceHost, username, password, basicUsername, basicPassword, tlsSkipVerify, 60*time.Second)
+const (
+	// warmUpTimeout bounds how long a request waits for a warm-up sync
+	warmUpTimeout = 5 * time.Second
+	// warmUpInterval is the minimum time between warm-up syncs of a client
+	warmUpInterval = 2 * time.Second
+)
+
+func NewClient(instanceID int, instanceHost, username, password string, basicUsername, basicPassword *string, tlsSkipVerify bool, proxyURL *url.URL) (*Client, error) {
+	return NewClientWithTimeout(instanceID, instanceHost, username, password, basicUsername, basicPassword, tlsSkipVerify, proxyURL, 60*time.Second)
 }
 
-func NewClientWithTimeout(instanceID int, instanceHost, username, password string, basicUsername, basicPassword *string, tlsSkipVerify bool, timeout time.Duration) (*Client, error) {
+// NewClientWithTimeout logs in to the instance and returns a client. When proxyURL is set, all
+// requests, including the login, go through the proxy.
+func NewClientWithTimeout(instanceID int, instanceHost, username, password string, basicUsername, basicPassword *string, tlsSkipVerify bool, proxyURL *url.URL, timeout time.Duration) (*Client, error) {
 	cfg := qbt.Config{
 		Host:          instanceHost,
 		Username:      username,
@@ -54,6 +74,15 @@ func NewClientWithTimeout(instanceID int, instanceHost, username, password strin
 
 	qbtClient := qbt.NewClient(cfg)
 
+	if proxyURL != nil {
+		// Replace only the proxy so TLS settings such as tlsSkipVerify still apply to the instance
+		transport, ok := qbtClient.GetHTTPClient().Transport.(*http.Transport)
+		if !ok {
+			return nil, fmt.Errorf("failed to configure proxy: unexpected transport %T", qbtClient.GetHTTPClient().Transport)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 
@@ -66,24 +95,22 @@ func NewClientWithTimeout(instanceID int, instanceHost, username, password strin
 		webAPIVersion = ""
 	}
 
-	supportsSetTags := false
-	if webAPIVersion != "" {
-		if v, err := semver.NewVersion(webAPIVersion); err == nil {
-			minVersion := semver.MustParse("2.11.4")
-			supportsSetTags = !v.LessThan(minVersion)
-		}
-	}
+	capabilities := newCapabilities(webAPIVersion)
 
 	client := &Client{
 		Client:          qbtClient,
 		instanceID:      instanceID,
+		host:            instanceHost,
+		basicUser:       cfg.BasicUser,
+		basicPass:       cfg.BasicPass,
 		webAPIVersion:   webAPIVersion,
-		supportsSetTags: supportsSetTags,
+		capabilities:    capabilities,
 		lastHealthCheck: time.Now(),
 		isHealthy:       true,
 		optimisticUpdates: ttlcache.New(ttlcache.Options[string, *OptimisticTorrentUpdate]{}.
 			SetDefaultTTL(30 * time.Second)), // Updates expire after 30 seconds
 		trackerExclusions: make(map[string]map[string]struct{}),
+		privateFlags:      ttlcache.New(ttlcache.Options[string, bool]{}.SetDefaultTTL(privateFlagTTL)),
 		peerSyncManager:   make(map[string]*qbt.PeerSyncManager),
 	}
 
@@ -94,22 +121,27 @@ func NewClientWithTimeout(instanceID int, instanceHost, username, password strin
 	// Set up health check callbacks
 	syncOpts.OnUpdate = func(data *qbt.MainData) {
 		client.updateHealthStatus(true)
+		client.setSyncError(nil)
+		client.markSynced()
 		log.Debug().Int("instanceID", instanceID).Int("torrentCount", len(data.Torrents)).Msg("Sync manager update received, marking client as healthy")
 	}
 
 	syncOpts.OnError = func(err error) {
 		client.updateHealthStatus(false)
+		client.setSyncError(err)
 		log.Warn().Err(err).Int("instanceID", instanceID).Msg("Sync manager error received, marking client as unhealthy")
 	}
 
+	client.syncOptions = syncOpts
 	client.syncManager = qbtClient.NewSyncManager(syncOpts)
 
 	log.Debug().
 		Int("instanceID", instanceID).
 		Str("host", instanceHost).
 		Str("webAPIVersion", webAPIVersion).
-		Bool("supportsSetTags", supportsSetTags).
+		Bool("supportsSetTags", capabilities.SetTags).
 		Bool("tlsSkipVerify", tlsSkipVerify).
+		Bool("proxy", proxyURL != nil).
 		Msg("qBittorrent client created successfully")
 
 	return client, nil
@@ -134,6 +166,36 @@ func (c *Client) GetLastSyncUpdate() time.Time {
 	return c.syncManager.LastSyncTime()
 }
 
+// SyncInterval returns how long synced data is served before the next request refreshes it
+func (c *Client) SyncInterval() time.Duration {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	var lastDuration time.Duration
+	if c.syncManager != nil {
+		lastDuration = c.syncManager.LastSyncDuration()
+	}
+	return syncInterval(c.syncOptions, lastDuration)
+}
+
+// syncInterval mirrors the sync manager's stale threshold: the configured interval when set,
+// otherwise twice the last sync duration bounded by the minimum and maximum intervals
+func syncInterval(opts qbt.SyncOptions, lastDuration time.Duration) time.Duration {
+	if opts.SyncInterval > 0 {
+		return opts.SyncInterval
+	}
+
+	if opts.DynamicSync && lastDuration > 0 {
+		interval := max(2*lastDuration, opts.MinSyncInterval)
+		return min(interval, opts.MaxSyncInterval)
+	}
+
+	if opts.MinSyncInterval > 0 {
+		return opts.MinSyncInterval
+	}
+	return 2 * time.Second
+}
+
 func (c *Client) updateHealthStatus(healthy bool) {
 	c.healthMu.Lock()
 	defer c.healthMu.Unlock()
@@ -141,6 +203,79 @@ func (c *Client) updateHealthStatus(healthy bool) {
 	c.lastHealthCheck = time.Now()
 }
 
+func (c *Client) setSyncError(err error) {
+	c.healthMu.Lock()
+	defer c.healthMu.Unlock()
+	c.lastSyncError = ""
+	if err != nil {
+		c.lastSyncError = err.Error()
+	}
+}
+
+// getSyncError returns the error of the last sync, or an empty string if it succeeded
+func (c *Client) getSyncError() string {
+	c.healthMu.RLock()
+	defer c.healthMu.RUnlock()
+	return c.lastSyncError
+}
+
+// markSynced records that the sync manager holds the instance's torrents
+func (c *Client) markSynced() {
+	c.healthMu.Lock()
+	defer c.healthMu.Unlock()
+	c.synced = true
+}
+
+// warmUp runs a bounded sync for a client whose first sync hasn't completed, e.g. because the
+// sync on startup failed, so the instance doesn't stay unavailable until a manual resync.
+// Attempts are spaced by warmUpInterval so a down instance isn't hammered by every request.
+// It reports whether the client is synced afterwards.
+func (c *Client) warmUp(ctx context.Context) bool {
+	c.healthMu.Lock()
+	if c.synced {
+		c.healthMu.Unlock()
+		return true
+	}
+	if time.Since(c.lastWarmUp) < warmUpInterval {
+		c.healthMu.Unlock()
+		return false
+	}
+	c.lastWarmUp = time.Now()
+	c.healthMu.Unlock()
+
+	syncManager := c.GetSyncManager()
+	if syncManager == nil {
+		return false
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, warmUpTimeout)
+	defer cancel()
+
+	// Sync waits for a sync already in progress regardless of ctx, so bound it from here
+	done := make(chan error, 1)
+	go func() { done <- syncManager.Sync(ctx) }()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			log.Debug().Err(err).Int("instanceID", c.instanceID).Msg("Warm-up sync failed")
+			return false
+		}
+		c.markSynced()
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// IsSynced reports whether the first sync has completed. Before that the torrent list is empty
+// rather than reflecting the instance.
+func (c *Client) IsSynced() bool {
+	c.healthMu.RLock()
+	defer c.healthMu.RUnlock()
+	return c.synced
+}
+
 func (c *Client) IsHealthy() bool {
 	c.healthMu.RLock()
 	defer c.healthMu.RUnlock()
@@ -177,7 +312,13 @@ func (c *Client) HealthCheck(ctx context.Context) error {
 func (c *Client) SupportsSetTags() bool {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
-	return c.supportsSetTags
+	return c.capabilities.SetTags
+}
+
+func (c *Client) Capabilities() Capabilities {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.capabilities
 }
 
 func (c *Client) GetWebAPIVersion() string {
@@ -201,6 +342,22 @@ func (c *Client) StartSyncManager(ctx context.Context) error {
 	return c.syncManager.Start(ctx)
 }
 
+// resetSyncManager replaces the sync manager with a new one built from the same options, so the
+// next sync is a full one from rid 0 instead of an incremental update of a drifted cache. The
+// current manager is kept if the full sync fails.
+func (c *Client) resetSyncManager(ctx context.Context) (*qbt.SyncManager, error) {
+	syncManager := c.Client.NewSyncManager(c.syncOptions)
+	if err := syncManager.Start(ctx); err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	c.syncManager = syncManager
+	c.mu.Unlock()
+
+	return syncManager, nil
+}
+
 // GetOrCreatePeerSyncManager gets or creates a PeerSyncManager for a specific torrent
 func (c *Client) GetOrCreatePeerSyncManager(hash string) *qbt.PeerSyncManager {
 	c.mu.Lock()
@@ -342,6 +499,19 @@ func (c *Client) clearTrackerExclusions(domains []string) {
 	}
 }
 
+// resetTransientState drops all optimistic updates and tracker exclusions so the next
+// read reflects only what qBittorrent reports
+func (c *Client) resetTransientState() {
+	for _, hash := range c.optimisticUpdates.GetKeys() {
+		c.optimisticUpdates.Delete(hash)
+	}
+
+	c.mu.Lock()
+	c.trackerExclusions = make(map[string]map[string]struct{})
+	c.queueOverlay = nil
+	c.mu.Unlock()
+}
+
 // getOptimisticUpdates returns all current optimistic updates
 func (c *Client) getOptimisticUpdates() map[string]*OptimisticTorrentUpdate {
 	updates := make(map[string]*OptimisticTorrentUpdate)
@@ -367,7 +537,7 @@ func getTargetState(action string, progress float64) qbt.TorrentState {
 			return qbt.TorrentStateQueuedUp
 		}
 		return qbt.TorrentStateQueuedDl
-	case "force_resume":
+	case "resumeForce":
 		if progress == 1.0 {
 			return qbt.TorrentStateForcedUp
 		}
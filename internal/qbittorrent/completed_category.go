@@ -0,0 +1,102 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+	"github.com/rs/zerolog/log"
+
+	"github.com/autobrr/qui/internal/models"
+)
+
+// CompletedCategoryMover moves torrents that finish downloading to the seeding category their
+// instance maps their category to. It reacts to the completed events of the timeline collector.
+// Moving to a category with Auto-TMM enabled also moves the data to that category's save path,
+// while torrents managed manually keep their data where it is.
+type CompletedCategoryMover struct {
+	store       *models.CompletedCategorySettingsStore
+	syncManager *SyncManager
+}
+
+func NewCompletedCategoryMover(store *models.CompletedCategorySettingsStore, syncManager *SyncManager) *CompletedCategoryMover {
+	return &CompletedCategoryMover{
+		store:       store,
+		syncManager: syncManager,
+	}
+}
+
+// HandleEvents moves the torrents of the completed events of an instance
+func (m *CompletedCategoryMover) HandleEvents(ctx context.Context, instanceID int, events []models.TorrentEvent) {
+	var completed []string
+	for _, event := range events {
+		if event.Event == models.TorrentEventCompleted {
+			completed = append(completed, event.Hash)
+		}
+	}
+	if len(completed) == 0 {
+		return
+	}
+
+	settings, err := m.store.Get(ctx, instanceID)
+	if err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to load completed category settings")
+		return
+	}
+	if !settings.Enabled || len(settings.Mappings) == 0 {
+		return
+	}
+
+	_, syncManager, err := m.syncManager.getClientAndSyncManager(ctx, instanceID)
+	if err != nil {
+		log.Warn().Err(err).Int("instanceID", instanceID).Msg("Failed to move completed torrents")
+		return
+	}
+
+	torrents := syncManager.GetTorrentMap(qbt.TorrentFilterOptions{Hashes: completed})
+	categories := syncManager.GetCategories()
+	moves := planCategoryMoves(torrents, completed, settings.Mappings)
+
+	for _, target := range slices.Sorted(maps.Keys(moves)) {
+		hashes := moves[target]
+		if err := validateCategory(categories, target); err != nil {
+			log.Warn().Err(err).Int("instanceID", instanceID).Strs("hashes", hashes).Msg("Skipping completed torrents, seeding category does not exist")
+			continue
+		}
+		if err := m.syncManager.SetCategory(ctx, instanceID, hashes, target); err != nil {
+			log.Error().Err(err).Int("instanceID", instanceID).Str("category", target).Msg("Failed to move completed torrents")
+			continue
+		}
+
+		for _, hash := range hashes {
+			torrent := torrents[hash]
+			log.Info().
+				Int("instanceID", instanceID).
+				Str("hash", hash).
+				Str("name", torrent.Name).
+				Str("from", torrent.Category).
+				Str("to", target).
+				Bool("autoTMM", torrent.AutoManaged).
+				Msg("Moved completed torrent to seeding category")
+		}
+	}
+}
+
+// planCategoryMoves groups the completed torrents whose category is mapped by their target category
+func planCategoryMoves(torrents map[string]qbt.Torrent, hashes []string, mappings map[string]string) map[string][]string {
+	moves := make(map[string][]string)
+	for _, hash := range hashes {
+		torrent, ok := torrents[hash]
+		if !ok {
+			continue
+		}
+		if target, ok := mappings[torrent.Category]; ok && target != torrent.Category {
+			moves[target] = append(moves[target], hash)
+		}
+	}
+	return moves
+}
@@ -0,0 +1,33 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"testing"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlanCategoryMoves(t *testing.T) {
+	torrents := map[string]qbt.Torrent{
+		"a": {Hash: "a", Category: "downloading"},
+		"b": {Hash: "b", Category: "movies-dl"},
+		"c": {Hash: "c", Category: ""},
+		"d": {Hash: "d", Category: "tv"},
+		"e": {Hash: "e", Category: "downloading"},
+	}
+	mappings := map[string]string{
+		"downloading": "seeding",
+		"movies-dl":   "movies",
+		"":            "seeding",
+	}
+
+	moves := planCategoryMoves(torrents, []string{"a", "b", "c", "d", "e", "missing"}, mappings)
+
+	assert.Equal(t, map[string][]string{
+		"seeding": {"a", "c", "e"},
+		"movies":  {"b"},
+	}, moves)
+}
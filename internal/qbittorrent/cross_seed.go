@@ -0,0 +1,260 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"slices"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+	"github.com/rs/zerolog/log"
+)
+
+// significantOverlapRatio is the share of the smaller torrent's bytes that must be
+// shared with another torrent before the two are treated as cross-seeds
+const significantOverlapRatio = 0.5
+
+// DeleteDowngrade describes a deleteWithFiles that was downgraded to keep a cross-seed's data
+type DeleteDowngrade struct {
+	Hash       string   `json:"hash"`
+	Name       string   `json:"name"`
+	SharedWith []string `json:"sharedWith"` // Hashes of torrents still using the files
+	Reason     string   `json:"reason"`
+}
+
+// DeleteResult represents the outcome of a delete that may have been downgraded
+type DeleteResult struct {
+	DeletedWithFiles []string          `json:"deletedWithFiles"`
+	Downgraded       []DeleteDowngrade `json:"downgraded,omitempty"`
+}
+
+// DuplicateMatch is a torrent that holds the same content as another torrent
+type DuplicateMatch struct {
+	Torrent      qbt.Torrent `json:"torrent"`
+	OverlapRatio float64     `json:"overlapRatio"` // Share of the smaller torrent's bytes in identical files
+}
+
+// FindDuplicatesForHash finds torrents on the instance with the same normalized name as the given
+// torrent whose files significantly overlap with it. Only same-name candidates have their file
+// lists fetched, so this is cheap compared to comparing the whole instance.
+func (sm *SyncManager) FindDuplicatesForHash(ctx context.Context, instanceID int, hash string) ([]DuplicateMatch, error) {
+	client, syncManager, err := sm.getClientAndSyncManager(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	target, exists := syncManager.GetTorrent(hash)
+	if !exists {
+		return nil, fmt.Errorf("torrent not found: %s", hash)
+	}
+
+	patterns := sm.getDuplicateStripPatterns(instanceID)
+	name := normalizeContentName(target.Name, patterns)
+	var candidates []qbt.Torrent
+	for _, torrent := range syncManager.GetTorrents(qbt.TorrentFilterOptions{}) {
+		if torrent.Hash != target.Hash && normalizeContentName(torrent.Name, patterns) == name {
+			candidates = append(candidates, torrent)
+		}
+	}
+
+	matches := []DuplicateMatch{}
+	if len(candidates) == 0 {
+		return matches, nil
+	}
+
+	targetFiles, err := client.GetFilesInformationCtx(ctx, target.Hash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get files for overlap check: %w", err)
+	}
+
+	for _, candidate := range candidates {
+		files, err := client.GetFilesInformationCtx(ctx, candidate.Hash)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get files for overlap check: %w", err)
+		}
+
+		ratio := fileOverlapRatio(*targetFiles, *files)
+		if ratio >= significantOverlapRatio {
+			matches = append(matches, DuplicateMatch{Torrent: candidate, OverlapRatio: ratio})
+		}
+	}
+
+	slices.SortStableFunc(matches, func(a, b DuplicateMatch) int {
+		return cmp.Compare(b.OverlapRatio, a.OverlapRatio)
+	})
+
+	return matches, nil
+}
+
+// DeleteTorrentsPreservingCrossSeeds deletes torrents with their files, except torrents whose files
+// are still used by another torrent in the same save path. Those are deleted without files and
+// reported as downgraded. Torrents that are being deleted together are not considered siblings.
+func (sm *SyncManager) DeleteTorrentsPreservingCrossSeeds(ctx context.Context, instanceID int, hashes []string) (*DeleteResult, error) {
+	client, syncManager, err := sm.getClientAndSyncManager(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	deleting := make(map[string]bool, len(hashes))
+	for _, hash := range hashes {
+		deleting[hash] = true
+	}
+
+	// Group remaining torrents by save path so each target only checks likely siblings
+	siblingsByPath := make(map[string][]qbt.Torrent)
+	for _, torrent := range syncManager.GetTorrents(qbt.TorrentFilterOptions{}) {
+		if deleting[torrent.Hash] {
+			continue
+		}
+		path := filepath.Clean(torrent.SavePath)
+		siblingsByPath[path] = append(siblingsByPath[path], torrent)
+	}
+
+	torrentMap := syncManager.GetTorrentMap(qbt.TorrentFilterOptions{Hashes: hashes})
+	filesCache := make(map[string]qbt.TorrentFiles)
+	getFiles := func(hash string) (qbt.TorrentFiles, error) {
+		if files, ok := filesCache[hash]; ok {
+			return files, nil
+		}
+		files, err := client.GetFilesInformationCtx(ctx, hash)
+		if err != nil {
+			return nil, err
+		}
+		filesCache[hash] = *files
+		return *files, nil
+	}
+
+	patterns := sm.getDuplicateStripPatterns(instanceID)
+	result := &DeleteResult{}
+	var keepFiles []string
+
+	for _, hash := range hashes {
+		torrent, exists := torrentMap[hash]
+		if !exists {
+			result.DeletedWithFiles = append(result.DeletedWithFiles, hash)
+			continue
+		}
+
+		// Only fetch file lists of siblings that could share files, a common download directory
+		// can hold thousands of torrents
+		var candidates []qbt.Torrent
+		for _, sibling := range siblingsByPath[filepath.Clean(torrent.SavePath)] {
+			if isCrossSeedCandidate(torrent, sibling, patterns) {
+				candidates = append(candidates, sibling)
+			}
+		}
+		if len(candidates) == 0 {
+			result.DeletedWithFiles = append(result.DeletedWithFiles, hash)
+			continue
+		}
+
+		files, err := getFiles(hash)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get files for overlap check: %w", err)
+		}
+
+		var sharedWith []string
+		for _, sibling := range candidates {
+			siblingFiles, err := getFiles(sibling.Hash)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get files for overlap check: %w", err)
+			}
+			if hasSignificantFileOverlap(files, siblingFiles) {
+				sharedWith = append(sharedWith, sibling.Hash)
+			}
+		}
+
+		if len(sharedWith) == 0 {
+			result.DeletedWithFiles = append(result.DeletedWithFiles, hash)
+			continue
+		}
+
+		keepFiles = append(keepFiles, hash)
+		result.Downgraded = append(result.Downgraded, DeleteDowngrade{
+			Hash:       hash,
+			Name:       torrent.Name,
+			SharedWith: sharedWith,
+			Reason:     "files are shared with another torrent in the same save path",
+		})
+	}
+
+	if len(result.DeletedWithFiles) > 0 {
+		sm.applyOptimisticCacheUpdate(instanceID, result.DeletedWithFiles, "deleteWithFiles", nil)
+		if err := client.DeleteTorrentsCtx(ctx, result.DeletedWithFiles, true); err != nil {
+			return nil, fmt.Errorf("failed to delete torrents with files: %w", err)
+		}
+	}
+
+	if len(keepFiles) > 0 {
+		sm.applyOptimisticCacheUpdate(instanceID, keepFiles, "delete", nil)
+		if err := client.DeleteTorrentsCtx(ctx, keepFiles, false); err != nil {
+			return nil, fmt.Errorf("failed to delete cross-seeded torrents: %w", err)
+		}
+
+		log.Info().
+			Int("instanceID", instanceID).
+			Int("downgraded", len(keepFiles)).
+			Msg("Kept files of cross-seeded torrents during delete")
+	}
+
+	return result, nil
+}
+
+// isCrossSeedCandidate reports whether a torrent in the same save path could share files with the
+// target. Shared file paths start with the same root, so cross-seeds have the same content path;
+// the name and size checks catch clients that don't report it and renamed roots.
+func isCrossSeedCandidate(target, sibling qbt.Torrent, patterns []*regexp.Regexp) bool {
+	if target.ContentPath != "" && filepath.Clean(target.ContentPath) == filepath.Clean(sibling.ContentPath) {
+		return true
+	}
+	if target.TotalSize > 0 && target.TotalSize == sibling.TotalSize {
+		return true
+	}
+	return normalizeContentName(target.Name, patterns) == normalizeContentName(sibling.Name, patterns)
+}
+
+// hasSignificantFileOverlap reports whether two torrents share enough files (by path and size)
+// that deleting one with its files would break the other
+func hasSignificantFileOverlap(a, b qbt.TorrentFiles) bool {
+	return fileOverlapRatio(a, b) >= significantOverlapRatio
+}
+
+// fileOverlapRatio returns the share of the smaller torrent's bytes that are in files with the
+// same path and size in the other torrent
+func fileOverlapRatio(a, b qbt.TorrentFiles) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+
+	type fileKey struct {
+		name string
+		size int64
+	}
+
+	var totalA, totalB int64
+	filesA := make(map[fileKey]struct{}, len(a))
+	for _, file := range a {
+		filesA[fileKey{name: filepath.ToSlash(file.Name), size: file.Size}] = struct{}{}
+		totalA += file.Size
+	}
+
+	var shared int64
+	for _, file := range b {
+		totalB += file.Size
+		if _, ok := filesA[fileKey{name: filepath.ToSlash(file.Name), size: file.Size}]; ok {
+			shared += file.Size
+		}
+	}
+
+	smaller := min(totalA, totalB)
+	if smaller == 0 {
+		return 0
+	}
+
+	return float64(shared) / float64(smaller)
+}
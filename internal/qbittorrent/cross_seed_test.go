@@ -0,0 +1,86 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"testing"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+	"github.com/stretchr/testify/assert"
+)
+
+func makeFiles(files map[string]int64) qbt.TorrentFiles {
+	result := make(qbt.TorrentFiles, 0, len(files))
+	for name, size := range files {
+		result = append(result, struct {
+			Availability float32 `json:"availability"`
+			Index        int     `json:"index"`
+			IsSeed       bool    `json:"is_seed,omitempty"`
+			Name         string  `json:"name"`
+			PieceRange   []int   `json:"piece_range"`
+			Priority     int     `json:"priority"`
+			Progress     float32 `json:"progress"`
+			Size         int64   `json:"size"`
+		}{Name: name, Size: size})
+	}
+	return result
+}
+
+func TestHasSignificantFileOverlap(t *testing.T) {
+	release := makeFiles(map[string]int64{
+		"Movie.2023/movie.mkv": 4000,
+		"Movie.2023/movie.nfo": 10,
+	})
+
+	testCases := []struct {
+		name     string
+		other    qbt.TorrentFiles
+		expected bool
+	}{
+		{"identical cross-seed", release, true},
+		{"cross-seed without nfo", makeFiles(map[string]int64{"Movie.2023/movie.mkv": 4000}), true},
+		{"same name different size", makeFiles(map[string]int64{"Movie.2023/movie.mkv": 3999}), false},
+		{"only small file shared", makeFiles(map[string]int64{"Movie.2023/movie.nfo": 10, "Movie.2023/other.mkv": 4000}), false},
+		{"no files", qbt.TorrentFiles{}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, hasSignificantFileOverlap(release, tc.other))
+		})
+	}
+}
+
+func TestFileOverlapRatio(t *testing.T) {
+	release := makeFiles(map[string]int64{
+		"Album/01.flac": 300,
+		"Album/02.flac": 100,
+	})
+
+	assert.InDelta(t, 1.0, fileOverlapRatio(release, release), 0.0001)
+	assert.InDelta(t, 0.75, fileOverlapRatio(release, makeFiles(map[string]int64{"Album/01.flac": 300, "Album/03.flac": 100})), 0.0001)
+	assert.InDelta(t, 1.0, fileOverlapRatio(release, makeFiles(map[string]int64{"Album/02.flac": 100})), 0.0001, "Ratio is relative to the smaller torrent")
+	assert.Zero(t, fileOverlapRatio(release, qbt.TorrentFiles{}))
+}
+
+func TestIsCrossSeedCandidate(t *testing.T) {
+	target := qbt.Torrent{Name: "Movie.2023.1080p", ContentPath: "/data/Movie.2023.1080p", TotalSize: 4010}
+
+	testCases := []struct {
+		name     string
+		sibling  qbt.Torrent
+		expected bool
+	}{
+		{"same content path", qbt.Torrent{Name: "Renamed", ContentPath: "/data/Movie.2023.1080p/", TotalSize: 4000}, true},
+		{"same size", qbt.Torrent{Name: "Renamed", ContentPath: "/data/Renamed", TotalSize: 4010}, true},
+		{"same name", qbt.Torrent{Name: "Movie.2023.1080p", TotalSize: 4000}, true},
+		{"unrelated", qbt.Torrent{Name: "Other.Movie", ContentPath: "/data/Other.Movie", TotalSize: 9000}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, isCrossSeedCandidate(target, tc.sibling, nil))
+		})
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/rs/zerolog/log"
+)
+
+// InstanceDashboardStats holds the dashboard totals of a single instance
+type InstanceDashboardStats struct {
+	InstanceID   int            `json:"instanceId"`
+	InstanceName string         `json:"instanceName"`
+	Total        int            `json:"total"`
+	TotalSize    int64          `json:"totalSize"`
+	Speeds       InstanceSpeeds `json:"speeds"`
+	Status       map[string]int `json:"status"`
+	Error        string         `json:"error,omitempty"`
+}
+
+// DashboardStats holds totals across instances along with the per-instance breakdown
+type DashboardStats struct {
+	Total     int                      `json:"total"`
+	TotalSize int64                    `json:"totalSize"`
+	Speeds    InstanceSpeeds           `json:"speeds"`
+	Status    map[string]int           `json:"status"`
+	Instances []InstanceDashboardStats `json:"instances"`
+}
+
+// GetDashboardStats gathers speeds and cached counts of every requested instance concurrently.
+// When instanceIDs is empty all configured instances are included. Instances that fail are
+// reported with an error in the breakdown and left out of the totals.
+func (sm *SyncManager) GetDashboardStats(ctx context.Context, instanceIDs []int) (*DashboardStats, error) {
+	instances, err := sm.clientPool.instanceStore.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list instances: %w", err)
+	}
+
+	var targets []*InstanceInfo
+	for _, instance := range instances {
+		if len(instanceIDs) > 0 && !slices.Contains(instanceIDs, instance.ID) {
+			continue
+		}
+		targets = append(targets, &InstanceInfo{ID: instance.ID, Name: instance.Name})
+	}
+
+	resultCh := make(chan InstanceDashboardStats, len(targets))
+
+	for _, target := range targets {
+		go func(target *InstanceInfo) {
+			stats, err := sm.getInstanceDashboardStats(ctx, target.ID)
+			if err != nil {
+				log.Warn().Err(err).Int("instanceID", target.ID).Msg("Failed to get dashboard stats for instance")
+				stats = InstanceDashboardStats{Error: err.Error()}
+			}
+			stats.InstanceID = target.ID
+			stats.InstanceName = target.Name
+			resultCh <- stats
+		}(target)
+	}
+
+	results := make([]InstanceDashboardStats, 0, len(targets))
+	for range targets {
+		results = append(results, <-resultCh)
+	}
+
+	return mergeDashboardStats(results), nil
+}
+
+func (sm *SyncManager) getInstanceDashboardStats(ctx context.Context, instanceID int) (InstanceDashboardStats, error) {
+	// GetTransferInfo is a lightweight call that returns global speeds without any torrent data
+	speeds, err := sm.GetInstanceSpeeds(ctx, instanceID)
+	if err != nil {
+		return InstanceDashboardStats{}, err
+	}
+
+	client, err := sm.clientPool.GetClient(ctx, instanceID)
+	if err != nil {
+		return InstanceDashboardStats{}, fmt.Errorf("failed to get client: %w", err)
+	}
+
+	torrents, err := sm.getAllTorrentsForStats(ctx, instanceID, "")
+	if err != nil {
+		return InstanceDashboardStats{}, err
+	}
+
+	// Tracker counts are not needed here, so skip MainData
+	counts := sm.calculateCountsFromTorrentsWithTrackers(client, torrents, nil)
+
+	var totalSize int64
+	for _, torrent := range torrents {
+		totalSize += torrent.Size
+	}
+
+	return InstanceDashboardStats{
+		Total:     counts.Total,
+		TotalSize: totalSize,
+		Speeds:    *speeds,
+		Status:    counts.Status,
+	}, nil
+}
+
+// mergeDashboardStats sums the successful instances and sorts the breakdown by instance ID
+func mergeDashboardStats(instances []InstanceDashboardStats) *DashboardStats {
+	stats := &DashboardStats{
+		Status:    make(map[string]int),
+		Instances: instances,
+	}
+
+	for _, instance := range instances {
+		if instance.Error != "" {
+			continue
+		}
+		stats.Total += instance.Total
+		stats.TotalSize += instance.TotalSize
+		stats.Speeds.Download += instance.Speeds.Download
+		stats.Speeds.Upload += instance.Speeds.Upload
+		for status, count := range instance.Status {
+			stats.Status[status] += count
+		}
+	}
+
+	slices.SortFunc(stats.Instances, func(a, b InstanceDashboardStats) int {
+		return a.InstanceID - b.InstanceID
+	})
+
+	return stats
+}
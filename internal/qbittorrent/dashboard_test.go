@@ -0,0 +1,42 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeDashboardStats(t *testing.T) {
+	stats := mergeDashboardStats([]InstanceDashboardStats{
+		{
+			InstanceID: 2,
+			Total:      3,
+			TotalSize:  300,
+			Speeds:     InstanceSpeeds{Download: 10, Upload: 20},
+			Status:     map[string]int{"seeding": 2, "downloading": 1},
+		},
+		{InstanceID: 3, Error: "connection refused"},
+		{
+			InstanceID: 1,
+			Total:      1,
+			TotalSize:  100,
+			Speeds:     InstanceSpeeds{Download: 5, Upload: 1},
+			Status:     map[string]int{"seeding": 1},
+		},
+	})
+
+	assert.Equal(t, 4, stats.Total)
+	assert.Equal(t, int64(400), stats.TotalSize)
+	assert.Equal(t, InstanceSpeeds{Download: 15, Upload: 21}, stats.Speeds)
+	assert.Equal(t, map[string]int{"seeding": 3, "downloading": 1}, stats.Status)
+
+	ids := make([]int, len(stats.Instances))
+	for i, instance := range stats.Instances {
+		ids[i] = instance.InstanceID
+	}
+	assert.Equal(t, []int{1, 2, 3}, ids)
+	assert.Equal(t, "connection refused", stats.Instances[2].Error)
+}
@@ -0,0 +1,271 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+	"github.com/rs/zerolog/log"
+
+	"github.com/autobrr/qui/internal/models"
+)
+
+// diskSpaceCheckInterval is how often instances with an enabled guard are checked
+const diskSpaceCheckInterval = time.Minute
+
+type diskSpaceAction int
+
+const (
+	diskSpaceKeep diskSpaceAction = iota
+	diskSpacePause
+	diskSpaceResume
+)
+
+// DiskSpaceStatus is the current state of an instance's low disk space guard
+type DiskSpaceStatus struct {
+	FreeSpaceBytes *int64     `json:"freeSpaceBytes,omitempty"` // Omitted until the guard has checked the instance
+	CheckedAt      *time.Time `json:"checkedAt,omitempty"`
+	Paused         bool       `json:"paused"`
+	PausedAt       *time.Time `json:"pausedAt,omitempty"`
+	PausedHashes   []string   `json:"pausedHashes"` // Torrents the guard paused and resumes later
+}
+
+// diskSpaceState is what the guard knows about an instance between checks
+type diskSpaceState struct {
+	freeSpace int64
+	checkedAt time.Time
+	pausedAt  time.Time
+	paused    map[string]struct{}
+}
+
+// DiskSpaceGuard pauses downloading torrents when an instance runs low on disk space, before
+// a full disk errors every torrent. Torrents it paused are resumed once free space is back
+// above the resume threshold, or by the user. Which torrents it paused is kept in memory, so
+// after a restart they have to be resumed by hand.
+type DiskSpaceGuard struct {
+	store       *models.DiskSpaceSettingsStore
+	syncManager *SyncManager
+
+	mu     sync.Mutex
+	states map[int]*diskSpaceState
+}
+
+func NewDiskSpaceGuard(store *models.DiskSpaceSettingsStore, syncManager *SyncManager) *DiskSpaceGuard {
+	return &DiskSpaceGuard{
+		store:       store,
+		syncManager: syncManager,
+		states:      make(map[int]*diskSpaceState),
+	}
+}
+
+// Start checks instances until ctx is cancelled
+func (g *DiskSpaceGuard) Start(ctx context.Context) {
+	ticker := time.NewTicker(diskSpaceCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			g.checkAll(ctx, time.Now())
+		}
+	}
+}
+
+// GetStatus returns the guard state of an instance
+func (g *DiskSpaceGuard) GetStatus(instanceID int) DiskSpaceStatus {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	status := DiskSpaceStatus{PausedHashes: []string{}}
+	state, ok := g.states[instanceID]
+	if !ok {
+		return status
+	}
+
+	freeSpace, checkedAt := state.freeSpace, state.checkedAt
+	status.FreeSpaceBytes = &freeSpace
+	status.CheckedAt = &checkedAt
+	if len(state.paused) > 0 {
+		pausedAt := state.pausedAt
+		status.Paused = true
+		status.PausedAt = &pausedAt
+		status.PausedHashes = slices.Sorted(maps.Keys(state.paused))
+	}
+	return status
+}
+
+// Resume resumes the torrents the guard paused on an instance and returns how many there were.
+// If free space is still below the threshold, the next check pauses them again.
+func (g *DiskSpaceGuard) Resume(ctx context.Context, instanceID int) (int, error) {
+	hashes := g.pausedHashes(instanceID)
+	if len(hashes) == 0 {
+		return 0, nil
+	}
+
+	if err := g.syncManager.BulkAction(ctx, instanceID, hashes, "resume"); err != nil {
+		return 0, fmt.Errorf("failed to resume torrents: %w", err)
+	}
+
+	g.clearPaused(instanceID)
+	return len(hashes), nil
+}
+
+// Forget drops the state of an instance, e.g. when its guard is disabled. Torrents it paused
+// stay paused.
+func (g *DiskSpaceGuard) Forget(instanceID int) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	delete(g.states, instanceID)
+}
+
+func (g *DiskSpaceGuard) checkAll(ctx context.Context, now time.Time) {
+	settings, err := g.store.ListEnabled(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to load disk space settings")
+		return
+	}
+
+	for instanceID, instanceSettings := range settings {
+		if err := g.check(ctx, instanceID, instanceSettings, now); err != nil {
+			log.Warn().Err(err).Int("instanceID", instanceID).Msg("Low disk space check failed")
+		}
+	}
+
+	// Forget instances whose guard was disabled or that were removed
+	g.mu.Lock()
+	for instanceID := range g.states {
+		if _, ok := settings[instanceID]; !ok {
+			delete(g.states, instanceID)
+		}
+	}
+	g.mu.Unlock()
+}
+
+func (g *DiskSpaceGuard) check(ctx context.Context, instanceID int, settings models.DiskSpaceSettings, now time.Time) error {
+	_, syncManager, err := g.syncManager.getClientAndSyncManager(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+
+	// Free space only changes with a sync, and nothing else syncs instances nobody is viewing
+	if err := syncManager.Sync(ctx); err != nil {
+		return fmt.Errorf("failed to sync instance: %w", err)
+	}
+
+	freeSpace := syncManager.GetServerState().FreeSpaceOnDisk
+	paused := g.recordCheck(instanceID, freeSpace, now)
+
+	switch decideDiskSpaceAction(settings, freeSpace, paused) {
+	case diskSpacePause:
+		hashes := downloadingHashes(syncManager.GetTorrents(qbt.TorrentFilterOptions{}))
+		if len(hashes) == 0 {
+			return nil
+		}
+		if err := g.syncManager.BulkAction(ctx, instanceID, hashes, "pause"); err != nil {
+			return fmt.Errorf("failed to pause downloading torrents: %w", err)
+		}
+		g.addPaused(instanceID, hashes, now)
+
+		// Record the pause as an instance error so it shows up in the UI
+		pauseErr := fmt.Errorf("free disk space (%d bytes) is below %d bytes, paused %d downloading torrents", freeSpace, settings.PauseBelowBytes, len(hashes))
+		log.Warn().Int("instanceID", instanceID).Int64("freeSpace", freeSpace).Int("paused", len(hashes)).Msg("Low disk space, paused downloading torrents")
+		if recordErr := g.syncManager.GetErrorStore().RecordError(ctx, instanceID, pauseErr); recordErr != nil {
+			log.Error().Err(recordErr).Int("instanceID", instanceID).Msg("Failed to record low disk space pause")
+		}
+	case diskSpaceResume:
+		resumed, err := g.Resume(ctx, instanceID)
+		if err != nil {
+			return err
+		}
+		log.Info().Int("instanceID", instanceID).Int64("freeSpace", freeSpace).Int("resumed", resumed).Msg("Disk space recovered, resumed torrents")
+	}
+
+	return nil
+}
+
+// decideDiskSpaceAction pauses downloads below the pause threshold and resumes the torrents the
+// guard paused once free space reaches the resume threshold, if one is set
+func decideDiskSpaceAction(settings models.DiskSpaceSettings, freeSpace int64, paused bool) diskSpaceAction {
+	switch {
+	case freeSpace < settings.PauseBelowBytes:
+		return diskSpacePause
+	case paused && settings.ResumeAboveBytes > 0 && freeSpace >= settings.ResumeAboveBytes:
+		return diskSpaceResume
+	default:
+		return diskSpaceKeep
+	}
+}
+
+// downloadingHashes returns the torrents that are downloading or waiting to download
+func downloadingHashes(torrents []qbt.Torrent) []string {
+	downloadingStates := torrentStateCategories[qbt.TorrentFilterDownloading]
+
+	var hashes []string
+	for _, torrent := range torrents {
+		if slices.Contains(downloadingStates, torrent.State) {
+			hashes = append(hashes, torrent.Hash)
+		}
+	}
+	return hashes
+}
+
+// recordCheck stores the free space of a check and reports whether the guard has torrents paused
+func (g *DiskSpaceGuard) recordCheck(instanceID int, freeSpace int64, now time.Time) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	state, ok := g.states[instanceID]
+	if !ok {
+		state = &diskSpaceState{paused: make(map[string]struct{})}
+		g.states[instanceID] = state
+	}
+	state.freeSpace = freeSpace
+	state.checkedAt = now
+	return len(state.paused) > 0
+}
+
+func (g *DiskSpaceGuard) addPaused(instanceID int, hashes []string, now time.Time) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	state, ok := g.states[instanceID]
+	if !ok {
+		return
+	}
+	if len(state.paused) == 0 {
+		state.pausedAt = now
+	}
+	for _, hash := range hashes {
+		state.paused[hash] = struct{}{}
+	}
+}
+
+func (g *DiskSpaceGuard) pausedHashes(instanceID int) []string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	state, ok := g.states[instanceID]
+	if !ok {
+		return nil
+	}
+	return slices.Collect(maps.Keys(state.paused))
+}
+
+func (g *DiskSpaceGuard) clearPaused(instanceID int) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if state, ok := g.states[instanceID]; ok {
+		state.paused = make(map[string]struct{})
+		state.pausedAt = time.Time{}
+	}
+}
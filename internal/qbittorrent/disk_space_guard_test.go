@@ -0,0 +1,60 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"testing"
+	"time"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/autobrr/qui/internal/models"
+)
+
+func TestDecideDiskSpaceAction(t *testing.T) {
+	settings := models.DiskSpaceSettings{Enabled: true, PauseBelowBytes: 100, ResumeAboveBytes: 200}
+
+	assert.Equal(t, diskSpacePause, decideDiskSpaceAction(settings, 50, false))
+	assert.Equal(t, diskSpacePause, decideDiskSpaceAction(settings, 50, true), "torrents added while paused are paused too")
+	assert.Equal(t, diskSpaceKeep, decideDiskSpaceAction(settings, 150, false))
+	assert.Equal(t, diskSpaceKeep, decideDiskSpaceAction(settings, 150, true), "stays paused between the thresholds")
+	assert.Equal(t, diskSpaceResume, decideDiskSpaceAction(settings, 200, true))
+	assert.Equal(t, diskSpaceKeep, decideDiskSpaceAction(settings, 500, false))
+
+	manual := models.DiskSpaceSettings{Enabled: true, PauseBelowBytes: 100}
+	assert.Equal(t, diskSpaceKeep, decideDiskSpaceAction(manual, 1000, true), "without a resume threshold resuming is manual")
+}
+
+func TestDownloadingHashes(t *testing.T) {
+	torrents := []qbt.Torrent{
+		{Hash: "dl", State: qbt.TorrentStateDownloading},
+		{Hash: "stalled", State: qbt.TorrentStateStalledDl},
+		{Hash: "queued", State: qbt.TorrentStateQueuedDl},
+		{Hash: "seeding", State: qbt.TorrentStateUploading},
+		{Hash: "paused", State: qbt.TorrentStatePausedDl},
+	}
+
+	assert.Equal(t, []string{"dl", "stalled", "queued"}, downloadingHashes(torrents))
+}
+
+func TestDiskSpaceGuardStatus(t *testing.T) {
+	guard := NewDiskSpaceGuard(nil, nil)
+	now := time.Unix(1_700_000_000, 0)
+
+	assert.Equal(t, DiskSpaceStatus{PausedHashes: []string{}}, guard.GetStatus(1))
+
+	assert.False(t, guard.recordCheck(1, 50, now))
+	guard.addPaused(1, []string{"b", "a"}, now)
+	assert.True(t, guard.recordCheck(1, 40, now.Add(time.Minute)))
+
+	status := guard.GetStatus(1)
+	assert.True(t, status.Paused)
+	assert.Equal(t, int64(40), *status.FreeSpaceBytes)
+	assert.Equal(t, now, *status.PausedAt)
+	assert.Equal(t, []string{"a", "b"}, status.PausedHashes)
+
+	guard.clearPaused(1)
+	assert.False(t, guard.GetStatus(1).Paused)
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"regexp"
+
+	"github.com/autobrr/qui/internal/models"
+)
+
+// SetDuplicateStripPatterns sets the patterns stripped from torrent names of an instance before
+// they are compared for duplicates
+func (sm *SyncManager) SetDuplicateStripPatterns(instanceID int, patterns []string) error {
+	compiled, err := models.CompileStripPatterns(patterns)
+	if err != nil {
+		return err
+	}
+
+	if len(compiled) == 0 {
+		sm.duplicateStripPatterns.Delete(instanceID)
+		return nil
+	}
+	sm.duplicateStripPatterns.Store(instanceID, compiled)
+	return nil
+}
+
+// PreviewContentName returns the name as duplicate detection compares it. When patterns is nil
+// the instance's configured patterns are used, so new patterns can be tried before saving them.
+func (sm *SyncManager) PreviewContentName(instanceID int, name string, patterns []string) (string, error) {
+	if patterns == nil {
+		return normalizeContentName(name, sm.getDuplicateStripPatterns(instanceID)), nil
+	}
+
+	compiled, err := models.CompileStripPatterns(patterns)
+	if err != nil {
+		return "", err
+	}
+	return normalizeContentName(name, compiled), nil
+}
+
+func (sm *SyncManager) getDuplicateStripPatterns(instanceID int) []*regexp.Regexp {
+	if patterns, ok := sm.duplicateStripPatterns.Load(instanceID); ok {
+		return patterns.([]*regexp.Regexp)
+	}
+	return nil
+}
+
+// normalizeContentName removes every strip pattern match from a name, then normalizes
+// separators and case the same way search does
+func normalizeContentName(name string, patterns []*regexp.Regexp) string {
+	for _, pattern := range patterns {
+		name = pattern.ReplaceAllString(name, " ")
+	}
+	return normalizeForSearch(name)
+}
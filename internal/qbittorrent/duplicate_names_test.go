@@ -0,0 +1,38 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/autobrr/qui/internal/models"
+)
+
+func TestNormalizeContentName(t *testing.T) {
+	assert.Equal(t, "some movie 2020 1080p bluray", normalizeContentName("Some.Movie.2020.1080p.BluRay", nil))
+
+	patterns, err := models.CompileStripPatterns([]string{`\b(1080p|2160p)\b`, `\bbluray\b`, `-\w+$`})
+	require.NoError(t, err)
+	assert.Equal(t, "some movie 2020", normalizeContentName("Some.Movie.2020.1080p.BluRay-GROUP", patterns))
+	assert.Equal(t, "some movie 2020", normalizeContentName("Some Movie (2020) [2160p]", patterns))
+}
+
+func TestPreviewContentName(t *testing.T) {
+	sm := &SyncManager{}
+	require.NoError(t, sm.SetDuplicateStripPatterns(1, []string{`\bm4b\b`}))
+
+	name, err := sm.PreviewContentName(1, "Author - Title.m4b", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "author title", name)
+
+	name, err = sm.PreviewContentName(1, "Author - Title.m4b", []string{})
+	require.NoError(t, err)
+	assert.Equal(t, "author title m4b", name)
+
+	_, err = sm.PreviewContentName(1, "Author - Title", []string{"("})
+	assert.ErrorIs(t, err, models.ErrInvalidStripPattern)
+}
@@ -0,0 +1,175 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+	"github.com/rs/zerolog/log"
+)
+
+// DuplicateTrackerGroup is a tracker a torrent has under several URLs that only differ in form,
+// such as the case of the host, a default port or a trailing slash
+type DuplicateTrackerGroup struct {
+	Kept       string   `json:"kept"`
+	Duplicates []string `json:"duplicates"`
+}
+
+// TorrentDuplicateTrackers are the duplicate trackers of one torrent
+type TorrentDuplicateTrackers struct {
+	Hash   string                  `json:"hash"`
+	Groups []DuplicateTrackerGroup `json:"groups"`
+	Error  string                  `json:"error,omitempty"` // Set when the duplicates could not be removed
+}
+
+// FindDuplicateTrackers returns the torrents that have the same tracker under more than one
+// URL. The first URL of each tracker is the one that is kept. Torrents without duplicates are
+// left out.
+func (sm *SyncManager) FindDuplicateTrackers(ctx context.Context, instanceID int, hashes []string) ([]TorrentDuplicateTrackers, error) {
+	client, _, err := sm.getClientAndSyncManager(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := sm.validateTorrentsExist(client, hashes, "find duplicate trackers"); err != nil {
+		return nil, err
+	}
+
+	return findDuplicateTrackers(ctx, client, hashes)
+}
+
+// DeduplicateTrackers removes the duplicate trackers of the torrents, keeping the first URL of
+// each tracker, and returns what was removed. Torrents whose duplicates could not be removed
+// are reported with an error; the error is only returned when nothing could be removed.
+func (sm *SyncManager) DeduplicateTrackers(ctx context.Context, instanceID int, hashes []string) ([]TorrentDuplicateTrackers, error) {
+	client, _, err := sm.getClientAndSyncManager(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := sm.validateTorrentsExist(client, hashes, "deduplicate trackers"); err != nil {
+		return nil, err
+	}
+
+	duplicates, err := findDuplicateTrackers(ctx, client, hashes)
+	if err != nil {
+		return nil, err
+	}
+	if len(duplicates) == 0 {
+		return duplicates, nil
+	}
+
+	var removed bool
+	var lastErr error
+	for i, torrent := range duplicates {
+		var urls []string
+		for _, group := range torrent.Groups {
+			urls = append(urls, group.Duplicates...)
+		}
+
+		// removeTrackers takes URLs separated by |
+		if err := client.RemoveTrackersCtx(ctx, torrent.Hash, strings.Join(urls, "|")); err != nil {
+			log.Error().Err(err).Str("hash", torrent.Hash).Msg("Failed to remove duplicate trackers")
+			duplicates[i].Error = err.Error()
+			lastErr = err
+			continue
+		}
+		removed = true
+	}
+
+	if !removed {
+		return duplicates, fmt.Errorf("failed to remove duplicate trackers: %w", lastErr)
+	}
+
+	sm.syncAfterModification(instanceID, client, "deduplicate_trackers")
+
+	return duplicates, nil
+}
+
+func findDuplicateTrackers(ctx context.Context, client *Client, hashes []string) ([]TorrentDuplicateTrackers, error) {
+	result := []TorrentDuplicateTrackers{}
+	var read bool
+	var lastErr error
+	for _, hash := range hashes {
+		trackers, err := client.GetTorrentTrackersCtx(ctx, hash)
+		if err != nil {
+			log.Error().Err(err).Str("hash", hash).Msg("Failed to get torrent trackers")
+			lastErr = err
+			continue
+		}
+		read = true
+
+		if groups := duplicateTrackerGroups(trackers); len(groups) > 0 {
+			result = append(result, TorrentDuplicateTrackers{Hash: hash, Groups: groups})
+		}
+	}
+
+	if !read && lastErr != nil {
+		return nil, fmt.Errorf("failed to get torrent trackers: %w", lastErr)
+	}
+
+	return result, nil
+}
+
+// duplicateTrackerGroups groups a torrent's tracker URLs by normalized URL, in tracker order,
+// and returns the groups with more than one URL. DHT, PeX and LSD entries are ignored.
+func duplicateTrackerGroups(trackers []qbt.TorrentTracker) []DuplicateTrackerGroup {
+	var groups []DuplicateTrackerGroup
+	index := make(map[string]int)
+	seen := make(map[string]string)
+
+	for _, tracker := range trackers {
+		if tracker.Url == "" || strings.HasPrefix(tracker.Url, "** [") {
+			continue
+		}
+
+		key := normalizeTrackerURL(tracker.Url)
+		kept, ok := seen[key]
+		if !ok {
+			seen[key] = tracker.Url
+			continue
+		}
+
+		i, ok := index[key]
+		if !ok {
+			i = len(groups)
+			index[key] = i
+			groups = append(groups, DuplicateTrackerGroup{Kept: kept})
+		}
+		groups[i].Duplicates = append(groups[i].Duplicates, tracker.Url)
+	}
+
+	return groups
+}
+
+// normalizeTrackerURL reduces a tracker URL to the parts that identify the tracker: the scheme
+// and host are lowercased, default ports and trailing slashes dropped. Paths and queries keep
+// their case since they often hold passkeys. URLs that don't parse are only trimmed.
+func normalizeTrackerURL(rawURL string) string {
+	trimmed := strings.TrimSpace(rawURL)
+	u, err := url.Parse(trimmed)
+	if err != nil || u.Host == "" {
+		return trimmed
+	}
+
+	scheme := strings.ToLower(u.Scheme)
+	host := strings.ToLower(u.Hostname())
+	port := u.Port()
+	if (scheme == "http" && port == "80") || (scheme == "https" && port == "443") {
+		port = ""
+	}
+	if port != "" {
+		host += ":" + port
+	}
+
+	normalized := scheme + "://" + host + strings.TrimRight(u.EscapedPath(), "/")
+	if u.RawQuery != "" {
+		normalized += "?" + u.RawQuery
+	}
+	return normalized
+}
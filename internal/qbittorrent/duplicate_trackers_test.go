@@ -0,0 +1,54 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"testing"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeTrackerURL(t *testing.T) {
+	assert.Equal(t, "https://tracker.example.com/announce", normalizeTrackerURL("HTTPS://Tracker.Example.com:443/announce/"))
+	assert.Equal(t, "http://tracker.example.com:8080/announce", normalizeTrackerURL(" http://tracker.example.com:8080/announce "))
+	assert.Equal(t, "udp://open.example.org:1337/announce", normalizeTrackerURL("udp://OPEN.example.org:1337/announce"))
+	assert.NotEqual(t,
+		normalizeTrackerURL("https://tracker.example.com/ABC/announce"),
+		normalizeTrackerURL("https://tracker.example.com/abc/announce"),
+		"passkeys in the path are case sensitive")
+}
+
+func TestDuplicateTrackerGroups(t *testing.T) {
+	trackers := []qbt.TorrentTracker{
+		{Url: "** [DHT] **"},
+		{Url: "** [PeX] **"},
+		{Url: "https://tracker.example.com/announce"},
+		{Url: "udp://open.example.org:1337/announce"},
+		{Url: "https://Tracker.example.com:443/announce/"},
+		{Url: "https://tracker.example.com/announce/"},
+		{Url: "udp://OPEN.example.org:1337/announce"},
+		{Url: "https://other.example.com/announce"},
+	}
+
+	groups := duplicateTrackerGroups(trackers)
+
+	assert.Equal(t, []DuplicateTrackerGroup{
+		{
+			Kept:       "https://tracker.example.com/announce",
+			Duplicates: []string{"https://Tracker.example.com:443/announce/", "https://tracker.example.com/announce/"},
+		},
+		{
+			Kept:       "udp://open.example.org:1337/announce",
+			Duplicates: []string{"udp://OPEN.example.org:1337/announce"},
+		},
+	}, groups)
+}
+
+func TestDuplicateTrackerGroupsNone(t *testing.T) {
+	assert.Empty(t, duplicateTrackerGroups([]qbt.TorrentTracker{
+		{Url: "https://a.example.com/announce"},
+		{Url: "https://b.example.com/announce"},
+	}))
+}
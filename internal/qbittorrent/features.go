@@ -0,0 +1,122 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/semver/v3"
+)
+
+// WebAPI features that depend on the qBittorrent version
+const (
+	// FeatureSetTags is torrents/setTags, which replaces all tags in one request
+	FeatureSetTags = "setTags"
+	// FeatureStopStart is qBittorrent 5's stop/start wording and endpoints in place of pause/resume
+	FeatureStopStart = "stopStart"
+	// FeatureInactiveSeedingLimit is the inactive seeding time share limit
+	FeatureInactiveSeedingLimit = "inactiveSeedingLimit"
+	// FeatureRSS is the rss/* API
+	FeatureRSS = "rss"
+	// FeatureSearchPlugins is the search/* API for search plugins
+	FeatureSearchPlugins = "searchPlugins"
+	// FeatureTorrentCreator is the torrentcreator/* API for creating .torrent files
+	FeatureTorrentCreator = "torrentCreator"
+	// FeatureTrackerTiers is torrents/addTrackers starting a new tier on every blank line
+	FeatureTrackerTiers = "trackerTiers"
+)
+
+// featureMinWebAPIVersions lists the first WebAPI version supporting each feature
+var featureMinWebAPIVersions = map[string]*semver.Version{
+	FeatureSetTags:   semver.MustParse("2.11.4"),
+	FeatureStopStart: semver.MustParse("2.11.0"),
+
+	FeatureInactiveSeedingLimit: semver.MustParse("2.9.2"),
+	FeatureRSS:                  semver.MustParse("2.0.0"),
+	FeatureSearchPlugins:        semver.MustParse("2.1.1"),
+	FeatureTorrentCreator:       semver.MustParse("2.10.4"),
+	FeatureTrackerTiers:         semver.MustParse("2.11.0"),
+}
+
+// InstanceVersion describes the qBittorrent build of an instance and what it supports
+type InstanceVersion struct {
+	AppVersion    string          `json:"appVersion"`
+	WebAPIVersion string          `json:"webAPIVersion"`
+	Features      map[string]bool `json:"features"`
+}
+
+// Capabilities reports which qui features can be used against an instance, so the UI only
+// offers actions its qBittorrent version accepts
+type Capabilities struct {
+	WebAPIVersion        string `json:"webAPIVersion"`
+	SetTags              bool   `json:"setTags"`
+	StopStart            bool   `json:"stopStart"`
+	InactiveSeedingLimit bool   `json:"inactiveSeedingLimit"`
+	RSS                  bool   `json:"rss"`
+	SearchPlugins        bool   `json:"searchPlugins"`
+	TorrentCreator       bool   `json:"torrentCreator"`
+	TrackerTiers         bool   `json:"trackerTiers"`
+}
+
+// GetCapabilities returns the feature capabilities of an instance. They are worked out once
+// when the client connects, since the version does not change within a session.
+func (sm *SyncManager) GetCapabilities(ctx context.Context, instanceID int) (Capabilities, error) {
+	client, err := sm.clientPool.GetClient(ctx, instanceID)
+	if err != nil {
+		return Capabilities{}, fmt.Errorf("failed to get client: %w", err)
+	}
+
+	return client.Capabilities(), nil
+}
+
+// GetInstanceVersion returns the qBittorrent and WebAPI versions of an instance along with
+// the version-dependent features it supports
+func (sm *SyncManager) GetInstanceVersion(ctx context.Context, instanceID int) (*InstanceVersion, error) {
+	client, err := sm.clientPool.GetClient(ctx, instanceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client: %w", err)
+	}
+
+	appVersion, err := client.GetAppVersionCtx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get app version: %w", err)
+	}
+
+	webAPIVersion := client.GetWebAPIVersion()
+
+	return &InstanceVersion{
+		AppVersion:    appVersion,
+		WebAPIVersion: webAPIVersion,
+		Features:      webAPIFeatures(webAPIVersion),
+	}, nil
+}
+
+// webAPIFeatures reports which version-dependent features a WebAPI version supports.
+// Unknown or unparsable versions support none of them.
+func webAPIFeatures(webAPIVersion string) map[string]bool {
+	features := make(map[string]bool, len(featureMinWebAPIVersions))
+
+	version, err := semver.NewVersion(webAPIVersion)
+	for feature, minVersion := range featureMinWebAPIVersions {
+		features[feature] = err == nil && !version.LessThan(minVersion)
+	}
+
+	return features
+}
+
+// newCapabilities derives the capabilities of a WebAPI version
+func newCapabilities(webAPIVersion string) Capabilities {
+	features := webAPIFeatures(webAPIVersion)
+	return Capabilities{
+		WebAPIVersion:        webAPIVersion,
+		SetTags:              features[FeatureSetTags],
+		StopStart:            features[FeatureStopStart],
+		InactiveSeedingLimit: features[FeatureInactiveSeedingLimit],
+		RSS:                  features[FeatureRSS],
+		SearchPlugins:        features[FeatureSearchPlugins],
+		TorrentCreator:       features[FeatureTorrentCreator],
+		TrackerTiers:         features[FeatureTrackerTiers],
+	}
+}
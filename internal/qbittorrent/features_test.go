@@ -0,0 +1,43 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebAPIFeatures(t *testing.T) {
+	features := webAPIFeatures("2.11.4")
+	assert.True(t, features[FeatureSetTags])
+	assert.True(t, features[FeatureStopStart])
+	assert.True(t, features[FeatureTorrentCreator])
+	assert.True(t, features[FeatureTrackerTiers])
+
+	features = webAPIFeatures("2.11.2")
+	assert.False(t, features[FeatureSetTags])
+	assert.True(t, features[FeatureStopStart])
+
+	features = webAPIFeatures("2.9.3")
+	assert.False(t, features[FeatureTrackerTiers])
+	assert.False(t, features[FeatureSetTags])
+	assert.False(t, features[FeatureStopStart])
+	assert.True(t, features[FeatureInactiveSeedingLimit])
+
+	for feature, supported := range webAPIFeatures("") {
+		assert.False(t, supported, feature)
+	}
+}
+
+func TestNewCapabilities(t *testing.T) {
+	assert.Equal(t, Capabilities{
+		WebAPIVersion:        "2.9.3",
+		InactiveSeedingLimit: true,
+		RSS:                  true,
+		SearchPlugins:        true,
+	}, newCapabilities("2.9.3"))
+
+	assert.Equal(t, Capabilities{}, newCapabilities(""))
+}
@@ -0,0 +1,156 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+)
+
+// File download priorities accepted by qBittorrent
+const (
+	FilePrioritySkip    = 0
+	FilePriorityNormal  = 1
+	FilePriorityHigh    = 6
+	FilePriorityMaximum = 7
+)
+
+// ErrInvalidFilePriority is returned when a file index or priority is not valid for the torrent
+var ErrInvalidFilePriority = errors.New("invalid file priority")
+
+// SetFilePriorities sets the download priority of individual files (file index -> priority).
+// All indices are validated against the torrent's file list before anything is changed.
+func (sm *SyncManager) SetFilePriorities(ctx context.Context, instanceID int, hash string, priorities map[int]int) error {
+	if len(priorities) == 0 {
+		return fmt.Errorf("%w: no file priorities provided", ErrInvalidFilePriority)
+	}
+
+	client, _, err := sm.getClientAndSyncManager(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+
+	files, err := client.GetFilesInformationCtx(ctx, hash)
+	if err != nil {
+		return fmt.Errorf("failed to get torrent files: %w", err)
+	}
+
+	if err := validateFilePriorities(*files, priorities); err != nil {
+		return err
+	}
+
+	if err := sm.setFilePriorities(ctx, client, hash, priorities); err != nil {
+		return err
+	}
+
+	sm.syncAfterModification(instanceID, client, "set_file_priorities")
+
+	return nil
+}
+
+// SkipFilesMatching sets priority 0 (do not download) on every file whose path or base name
+// matches the case-insensitive glob pattern, e.g. "*.nfo" or "*sample*". It returns the number
+// of files that were skipped.
+func (sm *SyncManager) SkipFilesMatching(ctx context.Context, instanceID int, hash, pattern string) (int, error) {
+	pattern = strings.ToLower(strings.TrimSpace(pattern))
+	if pattern == "" {
+		return 0, fmt.Errorf("pattern is required")
+	}
+	if _, err := path.Match(pattern, ""); err != nil {
+		return 0, fmt.Errorf("invalid pattern: %w", err)
+	}
+
+	client, _, err := sm.getClientAndSyncManager(ctx, instanceID)
+	if err != nil {
+		return 0, err
+	}
+
+	files, err := client.GetFilesInformationCtx(ctx, hash)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get torrent files: %w", err)
+	}
+
+	priorities := make(map[int]int)
+	for _, index := range matchFiles(*files, pattern) {
+		priorities[index] = FilePrioritySkip
+	}
+
+	if len(priorities) == 0 {
+		return 0, nil
+	}
+
+	if err := sm.setFilePriorities(ctx, client, hash, priorities); err != nil {
+		return 0, err
+	}
+
+	sm.syncAfterModification(instanceID, client, "skip_files")
+
+	return len(priorities), nil
+}
+
+// setFilePriorities groups files by priority so each priority needs a single request
+func (sm *SyncManager) setFilePriorities(ctx context.Context, client *Client, hash string, priorities map[int]int) error {
+	byPriority := make(map[int][]int)
+	for index, priority := range priorities {
+		byPriority[priority] = append(byPriority[priority], index)
+	}
+
+	for priority, indices := range byPriority {
+		slices.Sort(indices)
+		ids := make([]string, len(indices))
+		for i, index := range indices {
+			ids[i] = strconv.Itoa(index)
+		}
+
+		if err := client.SetFilePriorityCtx(ctx, hash, strings.Join(ids, "|"), priority); err != nil {
+			return fmt.Errorf("failed to set file priority: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// validateFilePriorities checks that every index exists in the file list and every priority is valid
+func validateFilePriorities(files qbt.TorrentFiles, priorities map[int]int) error {
+	indices := make(map[int]struct{}, len(files))
+	for _, file := range files {
+		indices[file.Index] = struct{}{}
+	}
+
+	for index, priority := range priorities {
+		if _, ok := indices[index]; !ok {
+			return fmt.Errorf("%w: file index %d does not exist", ErrInvalidFilePriority, index)
+		}
+		switch priority {
+		case FilePrioritySkip, FilePriorityNormal, FilePriorityHigh, FilePriorityMaximum:
+		default:
+			return fmt.Errorf("%w: priority %d for file %d", ErrInvalidFilePriority, priority, index)
+		}
+	}
+
+	return nil
+}
+
+// matchFiles returns the indices of files whose path or base name matches the lowercase glob pattern
+func matchFiles(files qbt.TorrentFiles, pattern string) []int {
+	var matched []int
+	for _, file := range files {
+		name := strings.ToLower(file.Name)
+		if ok, _ := path.Match(pattern, name); ok {
+			matched = append(matched, file.Index)
+			continue
+		}
+		if ok, _ := path.Match(pattern, path.Base(name)); ok {
+			matched = append(matched, file.Index)
+		}
+	}
+	return matched
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"testing"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+	"github.com/stretchr/testify/assert"
+)
+
+func testTorrentFiles(names ...string) qbt.TorrentFiles {
+	files := make(qbt.TorrentFiles, len(names))
+	for i, name := range names {
+		files[i].Index = i
+		files[i].Name = name
+	}
+	return files
+}
+
+func TestValidateFilePriorities(t *testing.T) {
+	files := testTorrentFiles("Movie/movie.mkv", "Movie/movie.nfo")
+
+	assert.NoError(t, validateFilePriorities(files, map[int]int{0: FilePriorityMaximum, 1: FilePrioritySkip}))
+	assert.ErrorIs(t, validateFilePriorities(files, map[int]int{2: FilePriorityNormal}), ErrInvalidFilePriority)
+	assert.ErrorIs(t, validateFilePriorities(files, map[int]int{0: 3}), ErrInvalidFilePriority)
+}
+
+func TestMatchFiles(t *testing.T) {
+	files := testTorrentFiles(
+		"Movie/movie.mkv",
+		"Movie/movie.NFO",
+		"Movie/Sample/movie-sample.mkv",
+		"Movie/Subs/english.srt",
+	)
+
+	assert.Equal(t, []int{1}, matchFiles(files, "*.nfo"))
+	assert.Equal(t, []int{2}, matchFiles(files, "*sample*"))
+	assert.Equal(t, []int{3}, matchFiles(files, "movie/subs/*"))
+	assert.Empty(t, matchFiles(files, "*.iso"))
+}
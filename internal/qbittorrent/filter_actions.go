@@ -0,0 +1,80 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"context"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+)
+
+// filterSelectionLimit is how many torrents a filter selection resolves to at most
+const filterSelectionLimit = 100000
+
+// ResolveHashesByFilter returns the hashes of the torrents matching the search and filters,
+// leaving out excluded hashes. This is the selection a user sees when selecting all rows.
+func (sm *SyncManager) ResolveHashesByFilter(ctx context.Context, instanceID int, search string, filters FilterOptions, excludeHashes []string) ([]string, error) {
+	response, err := sm.getTorrentsWithFilters(ctx, instanceID, filterSelectionLimit, 0, "added_on", "desc", false, search, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	return selectedHashes(response.Torrents, excludeHashes), nil
+}
+
+// AddTagsByFilter adds tags to every torrent matching the search and filters and returns
+// how many torrents were tagged
+func (sm *SyncManager) AddTagsByFilter(ctx context.Context, instanceID int, search string, filters FilterOptions, excludeHashes []string, tags []string) (int, error) {
+	return sm.applyByFilter(ctx, instanceID, search, filters, excludeHashes, func(hashes []string) error {
+		return sm.AddTagList(ctx, instanceID, hashes, tags)
+	})
+}
+
+// RemoveTagsByFilter removes tags from every torrent matching the search and filters and
+// returns how many torrents were affected
+func (sm *SyncManager) RemoveTagsByFilter(ctx context.Context, instanceID int, search string, filters FilterOptions, excludeHashes []string, tags []string) (int, error) {
+	return sm.applyByFilter(ctx, instanceID, search, filters, excludeHashes, func(hashes []string) error {
+		return sm.RemoveTagList(ctx, instanceID, hashes, tags)
+	})
+}
+
+// SetCategoryByFilter sets the category of every torrent matching the search and filters and
+// returns how many torrents were affected. An empty category removes it.
+func (sm *SyncManager) SetCategoryByFilter(ctx context.Context, instanceID int, search string, filters FilterOptions, excludeHashes []string, category string) (int, error) {
+	return sm.applyByFilter(ctx, instanceID, search, filters, excludeHashes, func(hashes []string) error {
+		return sm.SetCategory(ctx, instanceID, hashes, category)
+	})
+}
+
+func (sm *SyncManager) applyByFilter(ctx context.Context, instanceID int, search string, filters FilterOptions, excludeHashes []string, apply func(hashes []string) error) (int, error) {
+	hashes, err := sm.ResolveHashesByFilter(ctx, instanceID, search, filters, excludeHashes)
+	if err != nil {
+		return 0, err
+	}
+	if len(hashes) == 0 {
+		return 0, nil
+	}
+
+	if err := apply(hashes); err != nil {
+		return 0, err
+	}
+
+	return len(hashes), nil
+}
+
+// selectedHashes returns the hashes of torrents that are not excluded
+func selectedHashes(torrents []qbt.Torrent, excludeHashes []string) []string {
+	excluded := make(map[string]struct{}, len(excludeHashes))
+	for _, hash := range excludeHashes {
+		excluded[hash] = struct{}{}
+	}
+
+	hashes := make([]string, 0, len(torrents))
+	for _, torrent := range torrents {
+		if _, skip := excluded[torrent.Hash]; !skip {
+			hashes = append(hashes, torrent.Hash)
+		}
+	}
+	return hashes
+}
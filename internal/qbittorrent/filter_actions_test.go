@@ -0,0 +1,19 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"testing"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelectedHashes(t *testing.T) {
+	torrents := []qbt.Torrent{{Hash: "a"}, {Hash: "b"}, {Hash: "c"}}
+
+	assert.Equal(t, []string{"a", "b", "c"}, selectedHashes(torrents, nil))
+	assert.Equal(t, []string{"a", "c"}, selectedHashes(torrents, []string{"b", "unknown"}))
+	assert.Empty(t, selectedHashes(torrents, []string{"a", "b", "c"}))
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"context"
+	"fmt"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+)
+
+// ForceStartImpact compares the torrents that will run after a force start with the instance's
+// active torrent limit. Force start bypasses the limit, so exceeding it only warrants a warning.
+type ForceStartImpact struct {
+	Running           int    `json:"running"`           // Torrents running once the force start applies
+	Started           int    `json:"started"`           // Selected torrents that weren't running yet
+	MaxActiveTorrents int    `json:"maxActiveTorrents"` // -1 when unlimited or queueing is disabled
+	Warning           string `json:"warning,omitempty"`
+}
+
+// GetForceStartImpact estimates how many torrents will run after force starting hashes, against
+// the active torrent limit in the instance's preferences
+func (sm *SyncManager) GetForceStartImpact(ctx context.Context, instanceID int, hashes []string) (*ForceStartImpact, error) {
+	limits, err := sm.GetQueueLimits(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	torrents, err := sm.getAllTorrentsForStats(ctx, instanceID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	impact := forceStartImpact(torrents, hashes, *limits)
+	return &impact, nil
+}
+
+func forceStartImpact(torrents []qbt.Torrent, hashes []string, limits QueueLimits) ForceStartImpact {
+	selected := make(map[string]struct{}, len(hashes))
+	for _, hash := range hashes {
+		selected[hash] = struct{}{}
+	}
+
+	var impact ForceStartImpact
+	for _, torrent := range torrents {
+		running := isRunningUnderQueue(torrent.State)
+		if _, ok := selected[torrent.Hash]; ok && !running {
+			impact.Started++
+			running = true
+		}
+		if running {
+			impact.Running++
+		}
+	}
+
+	impact.MaxActiveTorrents = limits.MaxActiveTorrents
+	if !limits.QueueingEnabled {
+		impact.MaxActiveTorrents = -1
+	}
+	if impact.MaxActiveTorrents >= 0 && impact.Running > impact.MaxActiveTorrents {
+		impact.Warning = fmt.Sprintf("%d torrents will be running, %d over the limit of %d active torrents", impact.Running, impact.Running-impact.MaxActiveTorrents, impact.MaxActiveTorrents)
+	}
+
+	return impact
+}
+
+// isRunningUnderQueue reports whether a torrent takes one of the queue's active slots
+func isRunningUnderQueue(state qbt.TorrentState) bool {
+	switch state {
+	case qbt.TorrentStateQueuedDl, qbt.TorrentStateQueuedUp, qbt.TorrentStateError, qbt.TorrentStateMissingFiles:
+		return false
+	}
+	return !isStoppedState(state)
+}
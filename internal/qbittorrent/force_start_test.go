@@ -0,0 +1,35 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"testing"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestForceStartImpact(t *testing.T) {
+	torrents := []qbt.Torrent{
+		{Hash: "seeding", State: qbt.TorrentStateUploading},
+		{Hash: "stalled", State: qbt.TorrentStateStalledUp},
+		{Hash: "queued", State: qbt.TorrentStateQueuedDl},
+		{Hash: "stopped", State: qbt.TorrentStateStoppedUp},
+		{Hash: "idle", State: qbt.TorrentStatePausedDl},
+	}
+	limits := QueueLimits{QueueingEnabled: true, MaxActiveTorrents: 3}
+
+	within := forceStartImpact(torrents, []string{"queued"}, limits)
+	assert.Equal(t, ForceStartImpact{Running: 3, Started: 1, MaxActiveTorrents: 3}, within)
+
+	over := forceStartImpact(torrents, []string{"queued", "stopped", "seeding"}, limits)
+	assert.Equal(t, 4, over.Running)
+	assert.Equal(t, 2, over.Started, "already running torrents are not started again")
+	assert.Equal(t, "4 torrents will be running, 1 over the limit of 3 active torrents", over.Warning)
+
+	limits.QueueingEnabled = false
+	unlimited := forceStartImpact(torrents, []string{"queued", "stopped", "idle"}, limits)
+	assert.Equal(t, -1, unlimited.MaxActiveTorrents)
+	assert.Empty(t, unlimited.Warning)
+}
@@ -0,0 +1,142 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+	"github.com/rs/zerolog/log"
+)
+
+// DefaultGlobalSearchLimitPerInstance caps how many matches a single instance contributes to a global search
+const DefaultGlobalSearchLimitPerInstance = 100
+
+// GlobalSearchResult represents a torrent matched by a search across instances
+type GlobalSearchResult struct {
+	InstanceID   int         `json:"instanceId"`
+	InstanceName string      `json:"instanceName"`
+	Score        int         `json:"score"` // Lower is better, comparable across instances
+	Torrent      qbt.Torrent `json:"torrent"`
+}
+
+// GlobalSearchError describes an instance that could not be searched
+type GlobalSearchError struct {
+	InstanceID   int    `json:"instanceId"`
+	InstanceName string `json:"instanceName"`
+	Error        string `json:"error"`
+}
+
+// GlobalSearchResponse represents the merged result of a search across instances
+type GlobalSearchResponse struct {
+	Results []GlobalSearchResult `json:"results"`
+	Total   int                  `json:"total"`
+	Errors  []GlobalSearchError  `json:"errors,omitempty"`
+}
+
+// SearchAllInstances searches the cached torrents of every requested instance concurrently.
+// When instanceIDs is empty all configured instances are searched. Results are merged and
+// ranked by search score so exact matches rank first regardless of which instance holds them.
+func (sm *SyncManager) SearchAllInstances(ctx context.Context, query string, instanceIDs []int, limitPerInstance int) (*GlobalSearchResponse, error) {
+	if query == "" {
+		return nil, fmt.Errorf("search query is required")
+	}
+
+	if limitPerInstance <= 0 {
+		limitPerInstance = DefaultGlobalSearchLimitPerInstance
+	}
+
+	instances, err := sm.clientPool.instanceStore.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list instances: %w", err)
+	}
+
+	type instanceResult struct {
+		results []GlobalSearchResult
+		err     *GlobalSearchError
+	}
+
+	var targets []*InstanceInfo
+	for _, instance := range instances {
+		if len(instanceIDs) > 0 && !slices.Contains(instanceIDs, instance.ID) {
+			continue
+		}
+		targets = append(targets, &InstanceInfo{ID: instance.ID, Name: instance.Name})
+	}
+
+	resultCh := make(chan instanceResult, len(targets))
+
+	for _, target := range targets {
+		go func(target *InstanceInfo) {
+			client, syncManager, err := sm.getClientAndSyncManager(ctx, target.ID)
+			if err != nil {
+				resultCh <- instanceResult{err: &GlobalSearchError{
+					InstanceID:   target.ID,
+					InstanceName: target.Name,
+					Error:        err.Error(),
+				}}
+				return
+			}
+
+			matches := sm.rankTorrentsBySearch(syncManager.GetTorrents(qbt.TorrentFilterOptions{}), query, client.fuzzySearch)
+			if len(matches) > limitPerInstance {
+				matches = matches[:limitPerInstance]
+			}
+
+			results := make([]GlobalSearchResult, len(matches))
+			for i, match := range matches {
+				results[i] = GlobalSearchResult{
+					InstanceID:   target.ID,
+					InstanceName: target.Name,
+					Score:        match.score,
+					Torrent:      match.torrent,
+				}
+			}
+
+			resultCh <- instanceResult{results: results}
+		}(target)
+	}
+
+	response := &GlobalSearchResponse{
+		Results: []GlobalSearchResult{},
+	}
+
+	for range targets {
+		res := <-resultCh
+		if res.err != nil {
+			log.Warn().Int("instanceID", res.err.InstanceID).Str("error", res.err.Error).Msg("Global search failed for instance")
+			response.Errors = append(response.Errors, *res.err)
+			continue
+		}
+		response.Results = append(response.Results, res.results...)
+	}
+
+	sortGlobalSearchResults(response.Results)
+	response.Total = len(response.Results)
+
+	log.Debug().
+		Str("search", query).
+		Int("instances", len(targets)).
+		Int("matches", response.Total).
+		Int("failed", len(response.Errors)).
+		Msg("Global search completed")
+
+	return response, nil
+}
+
+// sortGlobalSearchResults orders results by score, then instance and name for stable output
+func sortGlobalSearchResults(results []GlobalSearchResult) {
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score < results[j].Score
+		}
+		if results[i].InstanceID != results[j].InstanceID {
+			return results[i].InstanceID < results[j].InstanceID
+		}
+		return results[i].Torrent.Name < results[j].Torrent.Name
+	})
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/autobrr/qui/internal/models"
+)
+
+// Instance health states reported by GetInstancesHealth
+const (
+	InstanceHealthOK           = "ok"
+	InstanceHealthDisconnected = "disconnected"
+	// InstanceHealthDecryptionError means stored credentials can't be decrypted, usually because
+	// the database was copied from another machine. Reconnecting won't help; re-enter the password.
+	InstanceHealthDecryptionError = "decryption_error"
+)
+
+// InstanceHealth describes the connection state of a single instance
+type InstanceHealth struct {
+	ID              int                   `json:"id"`
+	Name            string                `json:"name"`
+	Status          string                `json:"status"`
+	Connected       bool                  `json:"connected"`
+	DecryptionError bool                  `json:"decryptionError"`
+	LastError       *models.InstanceError `json:"lastError,omitempty"`
+}
+
+// GetInstancesHealth reports the cached connection state of every instance without
+// contacting qBittorrent, so it is cheap enough for uptime monitors to poll
+func (cp *ClientPool) GetInstancesHealth(ctx context.Context) ([]InstanceHealth, error) {
+	instances, err := cp.instanceStore.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list instances: %w", err)
+	}
+
+	decryptionErrors := cp.GetInstancesWithDecryptionErrors()
+
+	result := make([]InstanceHealth, 0, len(instances))
+	for _, instance := range instances {
+		health := InstanceHealth{
+			ID:              instance.ID,
+			Name:            instance.Name,
+			Connected:       cp.IsHealthy(instance.ID),
+			DecryptionError: slices.Contains(decryptionErrors, instance.ID),
+		}
+
+		switch {
+		case health.DecryptionError:
+			health.Status = InstanceHealthDecryptionError
+		case health.Connected:
+			health.Status = InstanceHealthOK
+		default:
+			health.Status = InstanceHealthDisconnected
+		}
+
+		if !health.Connected && cp.errorStore != nil {
+			if recent, err := cp.errorStore.GetRecentErrors(ctx, instance.ID, 1); err == nil && len(recent) > 0 {
+				health.LastError = &recent[0]
+			}
+		}
+
+		result = append(result, health)
+	}
+
+	return result, nil
+}
@@ -6,10 +6,14 @@ package qbittorrent
 import (
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/autobrr/autobrr/pkg/ttlcache"
 	qbt "github.com/autobrr/go-qbittorrent"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/autobrr/qui/internal/models"
 )
 
 // TestSyncManager_CacheIntegration tests the cache integration with SyncManager methods
@@ -98,7 +102,7 @@ func TestSyncManager_SearchFunctionality(t *testing.T) {
 	}
 
 	t.Run("filterTorrentsBySearch exact match", func(t *testing.T) {
-		results := sm.filterTorrentsBySearch(torrents, "ubuntu")
+		results := sm.filterTorrentsBySearch(torrents, "ubuntu", true)
 
 		// Should find 2 ubuntu torrents
 		assert.Len(t, results, 2, "Should find 2 Ubuntu torrents")
@@ -112,7 +116,7 @@ func TestSyncManager_SearchFunctionality(t *testing.T) {
 	})
 
 	t.Run("filterTorrentsBySearch fuzzy match", func(t *testing.T) {
-		results := sm.filterTorrentsBySearch(torrents, "2023")
+		results := sm.filterTorrentsBySearch(torrents, "2023", true)
 
 		// Should find torrents with 2023 in name or tags
 		assert.GreaterOrEqual(t, len(results), 2, "Should find at least 2 torrents with '2023'")
@@ -125,6 +129,15 @@ func TestSyncManager_SearchFunctionality(t *testing.T) {
 		}
 	})
 
+	t.Run("filterTorrentsBySearch without fuzzy tier", func(t *testing.T) {
+		short := []qbt.Torrent{{Name: "Ubuntu.ISO", Hash: "hash7"}}
+
+		// "ubntiso" only matches fuzzily
+		assert.Len(t, sm.filterTorrentsBySearch(short, "ubntiso", true), 1)
+		assert.Empty(t, sm.filterTorrentsBySearch(short, "ubntiso", false))
+		assert.Len(t, sm.filterTorrentsBySearch(torrents, "ubuntu", false), 2, "literal matches are unaffected")
+	})
+
 	t.Run("filterTorrentsByGlob pattern match", func(t *testing.T) {
 		results := sm.filterTorrentsByGlob(torrents, "*.iso")
 
@@ -220,7 +233,7 @@ func BenchmarkSyncManager_FilterTorrentsBySearch(b *testing.B) {
 	torrents := createTestTorrents(1000) // 1k torrents
 
 	for b.Loop() {
-		results := sm.filterTorrentsBySearch(torrents, "test-torrent-5")
+		results := sm.filterTorrentsBySearch(torrents, "test-torrent-5", true)
 		if len(results) == 0 {
 			b.Fatal("Should find at least one match")
 		}
@@ -261,3 +274,148 @@ func BenchmarkSyncManager_CacheOperations(b *testing.B) {
 		}
 	}
 }
+
+// TestSyncManager_GlobalSearchRanking tests that search scores stay comparable across instances
+func TestSyncManager_GlobalSearchRanking(t *testing.T) {
+	sm := &SyncManager{}
+
+	instanceA := []qbt.Torrent{
+		{Name: "Some.Ubuntu.Remix.iso", Hash: "a1"},
+		{Name: "Debian.12.iso", Hash: "a2"},
+	}
+	instanceB := []qbt.Torrent{
+		{Name: "ubuntu", Hash: "b1"},
+		{Name: "u.b.u.n.t.u", Hash: "b2"},
+	}
+
+	var results []GlobalSearchResult
+	for id, torrents := range map[int][]qbt.Torrent{1: instanceA, 2: instanceB} {
+		for _, match := range sm.rankTorrentsBySearch(torrents, "ubuntu", true) {
+			results = append(results, GlobalSearchResult{InstanceID: id, Score: match.score, Torrent: match.torrent})
+		}
+	}
+	sortGlobalSearchResults(results)
+
+	assert.Len(t, results, 3, "Should match both exact matches and the fuzzy match")
+	assert.Equal(t, 0, results[0].Score)
+	assert.Equal(t, 1, results[0].InstanceID, "Ties are ordered by instance ID")
+	assert.Equal(t, "b1", results[1].Torrent.Hash)
+	assert.Greater(t, results[2].Score, results[1].Score, "Fuzzy match should rank after exact matches")
+}
+
+// TestSyncManager_SearchWeights tests that field weights reorder matches within a tier
+func TestSyncManager_SearchWeights(t *testing.T) {
+	sm := &SyncManager{}
+
+	torrents := []qbt.Torrent{
+		{Name: "Linux.Distro.iso", Tags: "favorite", Hash: "tagged"},
+		{Name: "Favorite.Album.flac", Hash: "named"},
+	}
+
+	rank := func() []string {
+		var hashes []string
+		for _, match := range sm.rankTorrentsBySearch(torrents, "favorite", true) {
+			hashes = append(hashes, match.torrent.Hash)
+		}
+		return hashes
+	}
+
+	assert.Equal(t, []string{"tagged", "named"}, rank(), "Equal weights keep the original order")
+
+	sm.SetSearchWeights(models.SearchWeights{Name: 1, Category: 1, Tags: 3})
+	assert.Equal(t, []string{"tagged", "named"}, rank())
+
+	sm.SetSearchWeights(models.SearchWeights{Name: 3, Category: 1, Tags: 1})
+	assert.Equal(t, []string{"named", "tagged"}, rank(), "Name matches should rank first when name is weighted highest")
+}
+
+// TestSyncManager_SearchWeightsKeepTiers tests that field weights never lift a match above a better tier
+func TestSyncManager_SearchWeightsKeepTiers(t *testing.T) {
+	sm := &SyncManager{}
+	sm.SetSearchWeights(models.SearchWeights{Name: models.MaxSearchFieldWeight, Category: 0, Tags: 0})
+
+	torrents := []qbt.Torrent{
+		{Name: "Favourite", Hash: "fuzzy-name"},
+		{Name: "Linux.Distro.iso", Tags: "favorite", Hash: "exact-tag"},
+	}
+
+	var hashes []string
+	for _, match := range sm.rankTorrentsBySearch(torrents, "favorite", true) {
+		hashes = append(hashes, match.torrent.Hash)
+	}
+	assert.Equal(t, []string{"exact-tag", "fuzzy-name"}, hashes, "An exact tag match should outrank a fuzzy name match")
+}
+
+// TestSyncManager_OptimisticUpdateTimeout tests the configurable optimistic update safety net
+func TestSyncManager_OptimisticUpdateTimeout(t *testing.T) {
+	sm := &SyncManager{}
+	assert.Equal(t, DefaultOptimisticUpdateTimeout, sm.getOptimisticUpdateTimeout(), "Zero value should use the default")
+
+	sm.SetOptimisticUpdateTimeout(2 * time.Minute)
+	assert.Equal(t, 2*time.Minute, sm.getOptimisticUpdateTimeout())
+
+	sm.SetOptimisticUpdateTimeout(0)
+	assert.Equal(t, DefaultOptimisticUpdateTimeout, sm.getOptimisticUpdateTimeout(), "Non-positive values should restore the default")
+}
+
+// TestSyncedPastOptimisticUpdate tests that the sync grace follows the configured timeout
+func TestSyncedPastOptimisticUpdate(t *testing.T) {
+	updatedAt := time.Now()
+	lastSync := updatedAt.Add(20 * time.Second)
+
+	assert.Equal(t, 10*time.Second, optimisticSyncGrace(DefaultOptimisticUpdateTimeout))
+	assert.True(t, syncedPastOptimisticUpdate(updatedAt, lastSync, DefaultOptimisticUpdateTimeout),
+		"A sync 20s past the update should clear it with the default timeout")
+
+	assert.False(t, syncedPastOptimisticUpdate(updatedAt, lastSync, 5*time.Minute),
+		"Raising the timeout should keep the update through syncs shortly after it")
+	assert.True(t, syncedPastOptimisticUpdate(updatedAt, updatedAt.Add(time.Minute), 5*time.Minute))
+
+	for _, timeout := range []time.Duration{time.Second, DefaultOptimisticUpdateTimeout, time.Hour} {
+		assert.Less(t, optimisticSyncGrace(timeout), timeout, "The grace must fire before the safety net")
+	}
+}
+
+func TestClient_ResetTransientState(t *testing.T) {
+	client := &Client{
+		optimisticUpdates: ttlcache.New(ttlcache.Options[string, *OptimisticTorrentUpdate]{}),
+		trackerExclusions: make(map[string]map[string]struct{}),
+	}
+	client.optimisticUpdates.Set("hash1", &OptimisticTorrentUpdate{Action: "pause"}, time.Minute)
+	client.addTrackerExclusions("tracker.example", []string{"hash1"})
+
+	client.resetTransientState()
+
+	assert.Empty(t, client.getOptimisticUpdates())
+	assert.Nil(t, client.getTrackerExclusionsCopy())
+}
+
+func TestNewCacheMetadata(t *testing.T) {
+	lastSync := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	interval := 5 * time.Second
+
+	fresh := newCacheMetadata(lastSync, lastSync.Add(3*time.Second), interval)
+	assert.Equal(t, "fresh", fresh.Source)
+	assert.False(t, fresh.IsStale, "data within one sync interval should not be stale")
+	assert.Equal(t, lastSync.Add(interval).Format(time.RFC3339), fresh.NextRefresh)
+	assert.Equal(t, int64(5000), fresh.SyncIntervalMs)
+
+	stale := newCacheMetadata(lastSync, lastSync.Add(8*time.Second), interval)
+	assert.Equal(t, "cache", stale.Source)
+	assert.True(t, stale.IsStale)
+	assert.Equal(t, 8, stale.Age)
+	assert.Equal(t, lastSync.Add(8*time.Second).Format(time.RFC3339), stale.NextRefresh)
+}
+
+func TestSyncInterval(t *testing.T) {
+	opts := qbt.DefaultSyncOptions()
+
+	assert.Equal(t, opts.SyncInterval, syncInterval(opts, 0))
+	assert.Equal(t, opts.SyncInterval, syncInterval(opts, 3*time.Second), "a configured interval is used as is")
+
+	opts.SyncInterval = 0
+	assert.Equal(t, 6*time.Second, syncInterval(opts, 3*time.Second), "without an interval slow syncs stretch it")
+	assert.Equal(t, opts.MinSyncInterval, syncInterval(opts, 100*time.Millisecond))
+	assert.Equal(t, opts.MaxSyncInterval, syncInterval(opts, time.Minute))
+	assert.Equal(t, 2*time.Second, syncInterval(qbt.SyncOptions{}, 0))
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// jobRetention is how long a finished job's result stays available
+const jobRetention = time.Hour
+
+// Job statuses
+const (
+	JobRunning   = "running"
+	JobCompleted = "completed"
+	JobFailed    = "failed"
+	JobCancelled = "cancelled"
+)
+
+// Job is the progress and, once finished, the result of a bulk operation running in the background
+type Job struct {
+	ID         string     `json:"id"`
+	InstanceID int        `json:"instanceId"`
+	Operation  string     `json:"operation"`
+	Status     string     `json:"status"`
+	Total      int        `json:"total"`
+	Processed  int        `json:"processed"`
+	Failures   int        `json:"failures"`
+	Result     any        `json:"result,omitempty"`
+	Error      string     `json:"error,omitempty"`
+	StartedAt  time.Time  `json:"startedAt"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+}
+
+// JobProgress reports that one more item of a job was processed
+type JobProgress func(failed bool)
+
+// job is a running or finished job
+type job struct {
+	mu     sync.Mutex
+	status Job
+	cancel context.CancelFunc
+}
+
+func (j *job) progress(failed bool) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.status.Processed++
+	if failed {
+		j.status.Failures++
+	}
+}
+
+func (j *job) finish(result any, err error, now time.Time) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.status.Result = result
+	j.status.FinishedAt = &now
+	switch {
+	case errors.Is(err, context.Canceled):
+		j.status.Status = JobCancelled
+	case err != nil:
+		j.status.Status = JobFailed
+		j.status.Error = err.Error()
+	default:
+		j.status.Status = JobCompleted
+	}
+}
+
+func (j *job) snapshot() *Job {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	status := j.status
+	return &status
+}
+
+// startJob runs an operation over total items in the background and returns the job tracking it.
+// The operation reports each processed item and stops early when its context is cancelled.
+// Finished jobs are dropped after jobRetention.
+func (sm *SyncManager) startJob(instanceID int, operation string, total int, run func(ctx context.Context, progress JobProgress) (any, error)) *Job {
+	sm.pruneJobs(time.Now())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	j := &job{
+		status: Job{
+			ID:         rand.Text(),
+			InstanceID: instanceID,
+			Operation:  operation,
+			Status:     JobRunning,
+			Total:      total,
+			StartedAt:  time.Now(),
+		},
+		cancel: cancel,
+	}
+	sm.jobs.Store(j.status.ID, j)
+
+	go func() {
+		defer cancel()
+		result, err := run(ctx, j.progress)
+		if err != nil {
+			log.Warn().Err(err).Int("instanceID", instanceID).Str("job", j.status.ID).Str("operation", operation).Msg("Job stopped")
+		}
+		j.finish(result, err, time.Now())
+	}()
+
+	return j.snapshot()
+}
+
+// GetJob returns the status of a job, or nil if it doesn't exist or expired
+func (sm *SyncManager) GetJob(id string) *Job {
+	j, ok := sm.jobs.Load(id)
+	if !ok {
+		return nil
+	}
+	return j.(*job).snapshot()
+}
+
+// CancelJob stops a running job before its next item. Items already processed stay processed.
+func (sm *SyncManager) CancelJob(id string) bool {
+	j, ok := sm.jobs.Load(id)
+	if !ok {
+		return false
+	}
+	j.(*job).cancel()
+	return true
+}
+
+// pruneJobs drops jobs that finished more than jobRetention ago
+func (sm *SyncManager) pruneJobs(now time.Time) {
+	sm.jobs.Range(func(key, value any) bool {
+		status := value.(*job).snapshot()
+		if status.FinishedAt != nil && now.Sub(*status.FinishedAt) > jobRetention {
+			sm.jobs.Delete(key)
+		}
+		return true
+	})
+}
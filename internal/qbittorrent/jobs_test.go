@@ -0,0 +1,80 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func waitForJob(t *testing.T, sm *SyncManager, id string) *Job {
+	t.Helper()
+	var job *Job
+	require.Eventually(t, func() bool {
+		job = sm.GetJob(id)
+		return job != nil && job.Status != JobRunning
+	}, time.Second, 5*time.Millisecond)
+	return job
+}
+
+func TestStartJobReportsProgressAndResult(t *testing.T) {
+	sm := &SyncManager{}
+
+	started := sm.startJob(1, "addTrackers", 3, func(ctx context.Context, progress JobProgress) (any, error) {
+		progress(false)
+		progress(true)
+		progress(false)
+		return "done", nil
+	})
+	require.NotEmpty(t, started.ID)
+	assert.Equal(t, 3, started.Total)
+
+	job := waitForJob(t, sm, started.ID)
+	assert.Equal(t, JobCompleted, job.Status)
+	assert.Equal(t, 3, job.Processed)
+	assert.Equal(t, 1, job.Failures)
+	assert.Equal(t, "done", job.Result)
+	assert.NotNil(t, job.FinishedAt)
+}
+
+func TestStartJobFailureAndCancel(t *testing.T) {
+	sm := &SyncManager{}
+
+	failed := sm.startJob(1, "editTrackers", 1, func(ctx context.Context, progress JobProgress) (any, error) {
+		return nil, errors.New("boom")
+	})
+	job := waitForJob(t, sm, failed.ID)
+	assert.Equal(t, JobFailed, job.Status)
+	assert.Equal(t, "boom", job.Error)
+
+	cancelled := sm.startJob(1, "removeTrackers", 2, func(ctx context.Context, progress JobProgress) (any, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+	require.True(t, sm.CancelJob(cancelled.ID))
+	assert.Equal(t, JobCancelled, waitForJob(t, sm, cancelled.ID).Status)
+
+	assert.False(t, sm.CancelJob("missing"))
+	assert.Nil(t, sm.GetJob("missing"))
+}
+
+func TestPruneJobsDropsExpired(t *testing.T) {
+	sm := &SyncManager{}
+
+	started := sm.startJob(1, "addTrackers", 0, func(ctx context.Context, progress JobProgress) (any, error) {
+		return nil, nil
+	})
+	waitForJob(t, sm, started.ID)
+
+	sm.pruneJobs(time.Now())
+	assert.NotNil(t, sm.GetJob(started.ID))
+
+	sm.pruneJobs(time.Now().Add(jobRetention + time.Minute))
+	assert.Nil(t, sm.GetJob(started.ID))
+}
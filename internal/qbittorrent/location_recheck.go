@@ -0,0 +1,95 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+)
+
+// Outcomes of SetLocationAndRecheck for each torrent
+const (
+	LocationRechecked = "rechecked" // Moved to the new location and rechecked
+	LocationMoving    = "moving"    // Move not finished when the wait ran out, not rechecked
+	LocationMissing   = "missing"   // Not on the instance
+)
+
+// SetLocationAndRecheck moves the torrents to location, waits up to maxWait for each move to
+// register and then rechecks the torrents that arrived. Like SetLocation, this disables
+// Automatic Torrent Management for the torrents. It returns the outcome for every requested hash.
+func (sm *SyncManager) SetLocationAndRecheck(ctx context.Context, instanceID int, hashes []string, location string, maxWait time.Duration) (map[string]string, error) {
+	if err := sm.SetLocation(ctx, instanceID, hashes, location); err != nil {
+		return nil, err
+	}
+
+	client, syncManager, err := sm.getClientAndSyncManager(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	outcomes, err := pollTorrentOutcomes(ctx, instanceID, syncManager, recheckPollInterval, maxWait, "move", func(time.Time) (map[string]string, bool) {
+		torrents := syncManager.GetTorrentMap(qbt.TorrentFilterOptions{Hashes: hashes})
+		return classifyMove(hashes, torrents, location)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var moved []string
+	for _, hash := range hashes {
+		if outcomes[hash] == LocationRechecked {
+			moved = append(moved, hash)
+		}
+	}
+
+	if len(moved) > 0 {
+		sm.applyOptimisticCacheUpdate(instanceID, moved, "recheck", nil)
+		if err := client.RecheckCtx(ctx, moved); err != nil {
+			for _, hash := range moved {
+				client.clearOptimisticUpdate(hash)
+			}
+			return nil, fmt.Errorf("failed to recheck moved torrents: %w", err)
+		}
+	}
+
+	sm.syncAfterModification(instanceID, client, "set_location_and_recheck")
+
+	return outcomes, nil
+}
+
+// classifyMove works out which torrents finished moving to location and whether any are still
+// moving. Until the move registers, qBittorrent reports the old save path or a moving state.
+func classifyMove(hashes []string, torrents map[string]qbt.Torrent, location string) (map[string]string, bool) {
+	target := normalizeSavePath(location)
+
+	outcomes := make(map[string]string, len(hashes))
+	pending := false
+	for _, hash := range hashes {
+		torrent, exists := torrents[hash]
+		switch {
+		case !exists:
+			outcomes[hash] = LocationMissing
+		case torrent.State == qbt.TorrentStateMoving || normalizeSavePath(torrent.SavePath) != target:
+			outcomes[hash] = LocationMoving
+			pending = true
+		default:
+			outcomes[hash] = LocationRechecked
+		}
+	}
+
+	return outcomes, pending
+}
+
+// normalizeSavePath drops trailing separators so "/data/" and "/data" compare equal
+func normalizeSavePath(path string) string {
+	trimmed := strings.TrimRight(strings.TrimSpace(path), `/\`)
+	if trimmed == "" {
+		return path
+	}
+	return trimmed
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"testing"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassifyMove(t *testing.T) {
+	hashes := []string{"moved", "old", "moving", "missing"}
+	torrents := map[string]qbt.Torrent{
+		"moved":  {State: qbt.TorrentStatePausedUp, SavePath: "/data/new/"},
+		"old":    {State: qbt.TorrentStatePausedUp, SavePath: "/data/old"},
+		"moving": {State: qbt.TorrentStateMoving, SavePath: "/data/new"},
+	}
+
+	outcomes, pending := classifyMove(hashes, torrents, "/data/new")
+	assert.True(t, pending)
+	assert.Equal(t, map[string]string{
+		"moved":   LocationRechecked,
+		"old":     LocationMoving,
+		"moving":  LocationMoving,
+		"missing": LocationMissing,
+	}, outcomes)
+
+	torrents["old"] = qbt.Torrent{State: qbt.TorrentStateStalledUp, SavePath: "/data/new"}
+	torrents["moving"] = qbt.Torrent{State: qbt.TorrentStateStalledUp, SavePath: "/data/new"}
+
+	outcomes, pending = classifyMove(hashes, torrents, "/data/new/")
+	assert.False(t, pending)
+	assert.Equal(t, LocationRechecked, outcomes["old"])
+	assert.Equal(t, LocationRechecked, outcomes["moving"])
+}
+
+func TestNormalizeSavePath(t *testing.T) {
+	assert.Equal(t, "/data", normalizeSavePath("/data/"))
+	assert.Equal(t, `D:\Downloads`, normalizeSavePath(`D:\Downloads\`))
+	assert.Equal(t, "/", normalizeSavePath("/"))
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+)
+
+// TorrentMagnet is the magnet link of a torrent
+type TorrentMagnet struct {
+	Hash   string `json:"hash"`
+	Name   string `json:"name"`
+	Magnet string `json:"magnet"`
+}
+
+// GetMagnetLinks builds magnet links for the torrents, in the order of hashes, from their
+// infohashes, name and trackers. Hashes that are not on the instance are left out.
+func (sm *SyncManager) GetMagnetLinks(ctx context.Context, instanceID int, hashes []string) ([]TorrentMagnet, error) {
+	client, _, err := sm.getClientAndSyncManager(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	magnets := make([]TorrentMagnet, 0, len(hashes))
+	for _, torrent := range orderTorrents(client.getTorrentsByHashes(hashes), hashes) {
+		trackers, err := client.GetTorrentTrackersCtx(ctx, torrent.Hash)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get trackers for %s: %w", torrent.Hash, err)
+		}
+
+		magnets = append(magnets, TorrentMagnet{
+			Hash:   torrent.Hash,
+			Name:   torrent.Name,
+			Magnet: buildMagnet(torrent, trackers),
+		})
+	}
+
+	return magnets, nil
+}
+
+// GetInfohashes returns the full infohash of each torrent, in the order of hashes. Torrents
+// with a v1 infohash use it; v2-only torrents use their full v2 infohash rather than the
+// truncated ID qBittorrent uses for them. Hashes that are not on the instance are left out.
+func (sm *SyncManager) GetInfohashes(ctx context.Context, instanceID int, hashes []string) ([]string, error) {
+	client, _, err := sm.getClientAndSyncManager(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	infohashes := make([]string, 0, len(hashes))
+	for _, torrent := range orderTorrents(client.getTorrentsByHashes(hashes), hashes) {
+		infohashes = append(infohashes, primaryInfohash(torrent))
+	}
+
+	return infohashes, nil
+}
+
+// buildMagnet builds a magnet link. Hybrid torrents get both a v1 (btih) and a v2 (btmh)
+// exact topic so clients of either kind can use the link. DHT, PeX and LSD entries in the
+// tracker list are not trackers and are skipped.
+func buildMagnet(torrent qbt.Torrent, trackers []qbt.TorrentTracker) string {
+	var params []string
+
+	infohashV1 := torrent.InfohashV1
+	if infohashV1 == "" && torrent.InfohashV2 == "" {
+		infohashV1 = torrent.Hash
+	}
+	if infohashV1 != "" {
+		params = append(params, "xt=urn:btih:"+infohashV1)
+	}
+	if torrent.InfohashV2 != "" {
+		// 0x12 0x20 is the multihash prefix for a 32 byte SHA-256 digest
+		params = append(params, "xt=urn:btmh:1220"+torrent.InfohashV2)
+	}
+
+	if torrent.Name != "" {
+		params = append(params, "dn="+url.QueryEscape(torrent.Name))
+	}
+
+	for _, tracker := range trackers {
+		if tracker.Url == "" || strings.HasPrefix(tracker.Url, "** [") {
+			continue
+		}
+		params = append(params, "tr="+url.QueryEscape(tracker.Url))
+	}
+
+	return "magnet:?" + strings.Join(params, "&")
+}
+
+func primaryInfohash(torrent qbt.Torrent) string {
+	switch {
+	case torrent.InfohashV1 != "":
+		return torrent.InfohashV1
+	case torrent.InfohashV2 != "":
+		return torrent.InfohashV2
+	default:
+		return torrent.Hash
+	}
+}
+
+// orderTorrents returns the torrents in the order of hashes, skipping hashes without a torrent
+func orderTorrents(torrents []qbt.Torrent, hashes []string) []qbt.Torrent {
+	byHash := make(map[string]qbt.Torrent, len(torrents))
+	for _, torrent := range torrents {
+		byHash[torrent.Hash] = torrent
+	}
+
+	ordered := make([]qbt.Torrent, 0, len(torrents))
+	for _, hash := range hashes {
+		if torrent, ok := byHash[hash]; ok {
+			ordered = append(ordered, torrent)
+			delete(byHash, hash)
+		}
+	}
+	return ordered
+}
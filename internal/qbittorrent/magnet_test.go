@@ -0,0 +1,37 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"testing"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildMagnet(t *testing.T) {
+	trackers := []qbt.TorrentTracker{
+		{Url: "** [DHT] **"},
+		{Url: "** [PeX] **"},
+		{Url: "https://tracker.example/announce?passkey=abc"},
+	}
+
+	v1 := qbt.Torrent{Hash: "aaaa", InfohashV1: "aaaa", Name: "Some Release"}
+	assert.Equal(t,
+		"magnet:?xt=urn:btih:aaaa&dn=Some+Release&tr=https%3A%2F%2Ftracker.example%2Fannounce%3Fpasskey%3Dabc",
+		buildMagnet(v1, trackers))
+
+	hybrid := qbt.Torrent{Hash: "aaaa", InfohashV1: "aaaa", InfohashV2: "bbbb"}
+	assert.Equal(t, "magnet:?xt=urn:btih:aaaa&xt=urn:btmh:1220bbbb", buildMagnet(hybrid, nil))
+
+	v2 := qbt.Torrent{Hash: "bbbb", InfohashV2: "bbbbcccc"}
+	assert.Equal(t, "magnet:?xt=urn:btmh:1220bbbbcccc", buildMagnet(v2, nil))
+	assert.Equal(t, "bbbbcccc", primaryInfohash(v2))
+}
+
+func TestOrderTorrents(t *testing.T) {
+	torrents := []qbt.Torrent{{Hash: "b"}, {Hash: "a"}}
+	ordered := orderTorrents(torrents, []string{"a", "missing", "b", "a"})
+	assert.Equal(t, []qbt.Torrent{{Hash: "a"}, {Hash: "b"}}, ordered)
+}
@@ -0,0 +1,214 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+	"github.com/rs/zerolog/log"
+)
+
+// migrateVerifyAttempts and migrateVerifyInterval control how long MigrateTorrent waits for
+// the destination to report the re-added torrent
+const (
+	migrateVerifyAttempts = 10
+	migrateVerifyInterval = 500 * time.Millisecond
+)
+
+// MigrateResult describes the outcome of moving a torrent between instances
+type MigrateResult struct {
+	SourceInstanceID      int    `json:"sourceInstanceId"`
+	DestinationInstanceID int    `json:"destinationInstanceId"`
+	Hash                  string `json:"hash"`
+	NewHash               string `json:"newHash"` // Hash of the torrent on the destination
+	RemovedFromSource     bool   `json:"removedFromSource"`
+	RollbackNote          string `json:"rollbackNote,omitempty"`
+}
+
+// MigrateError is returned when a migration fails, with a note on the state it left behind
+type MigrateError struct {
+	Err          error
+	RollbackNote string
+}
+
+func (e *MigrateError) Error() string { return e.Err.Error() }
+func (e *MigrateError) Unwrap() error { return e.Err }
+
+// MigrateTorrent moves a torrent from one instance to another. The .torrent is exported from the
+// source and re-added to the destination with the same category, tags and save path; qBittorrent's
+// WebAPI does not expose fastresume data, so the destination rechecks the existing files. Both
+// instances must therefore see the data at the same save path. When deleteFromSource is set the
+// torrent is removed from the source (keeping files) once the destination has it.
+func (sm *SyncManager) MigrateTorrent(ctx context.Context, srcInstanceID, dstInstanceID int, hash string, deleteFromSource bool) (*MigrateResult, error) {
+	if srcInstanceID == dstInstanceID {
+		return nil, fmt.Errorf("source and destination instance must differ")
+	}
+
+	srcClient, srcSync, err := sm.getClientAndSyncManager(ctx, srcInstanceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get source instance: %w", err)
+	}
+
+	dstClient, _, err := sm.getClientAndSyncManager(ctx, dstInstanceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get destination instance: %w", err)
+	}
+
+	torrentMap := srcSync.GetTorrentMap(qbt.TorrentFilterOptions{Hashes: []string{hash}})
+	torrent, exists := torrentMap[hash]
+	if !exists {
+		return nil, fmt.Errorf("torrent not found on source instance: %s", hash)
+	}
+
+	candidates := migrateHashCandidates(torrent)
+	if existing, err := dstClient.GetTorrentsCtx(ctx, qbt.TorrentFilterOptions{Hashes: candidates}); err == nil {
+		if _, found := findMigratedTorrent(existing, torrent); found {
+			return nil, fmt.Errorf("torrent already exists on destination instance: %s", hash)
+		}
+	}
+
+	data, err := srcClient.ExportTorrentCtx(ctx, hash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export torrent: %w", err)
+	}
+
+	options := migrateAddOptions(torrent)
+	if err := dstClient.AddTorrentFromMemoryCtx(ctx, data, options); err != nil {
+		return nil, &MigrateError{
+			Err:          fmt.Errorf("failed to add torrent to destination: %w", err),
+			RollbackNote: "Nothing to roll back: the torrent is unchanged on the source instance",
+		}
+	}
+	sm.syncAfterModification(dstInstanceID, dstClient, "migrate_torrent")
+
+	// qBittorrent adds torrents asynchronously, so wait until the destination reports it
+	newHash, err := waitForTorrent(ctx, dstClient, torrent)
+	if err != nil {
+		return nil, &MigrateError{
+			Err:          fmt.Errorf("destination did not report migrated torrent: %w", err),
+			RollbackNote: "The torrent is unchanged on the source instance; check the destination for a partially added copy",
+		}
+	}
+
+	result := &MigrateResult{
+		SourceInstanceID:      srcInstanceID,
+		DestinationInstanceID: dstInstanceID,
+		Hash:                  hash,
+		NewHash:               newHash,
+	}
+
+	if deleteFromSource {
+		if err := srcClient.DeleteTorrentsCtx(ctx, []string{hash}, false); err != nil {
+			log.Warn().Err(err).Int("instanceID", srcInstanceID).Str("hash", hash).Msg("Failed to remove migrated torrent from source")
+			result.RollbackNote = "The torrent was added to the destination but could not be removed from the source; it now exists on both instances"
+		} else {
+			result.RemovedFromSource = true
+			sm.syncAfterModification(srcInstanceID, srcClient, "migrate_torrent")
+		}
+	}
+
+	log.Info().
+		Int("sourceInstanceID", srcInstanceID).
+		Int("destinationInstanceID", dstInstanceID).
+		Str("hash", hash).
+		Str("newHash", newHash).
+		Bool("removedFromSource", result.RemovedFromSource).
+		Msg("Migrated torrent between instances")
+
+	return result, nil
+}
+
+// migrateAddOptions builds add options that recreate the source torrent's placement and state
+func migrateAddOptions(torrent qbt.Torrent) map[string]string {
+	options := map[string]string{
+		"savepath": torrent.SavePath,
+		"autoTMM":  "false",
+	}
+
+	if torrent.Category != "" {
+		options["category"] = torrent.Category
+	}
+
+	// Torrent tags are reported as ", " separated; normalise so no tag gains a leading space
+	var tags []string
+	for tag := range strings.SplitSeq(torrent.Tags, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	if len(tags) > 0 {
+		options["tags"] = strings.Join(tags, ",")
+	}
+
+	switch torrent.State {
+	case qbt.TorrentStatePausedUp, qbt.TorrentStatePausedDl, qbt.TorrentStateStoppedUp, qbt.TorrentStateStoppedDl:
+		options["paused"] = "true"
+		options["stopped"] = "true"
+	}
+
+	return options
+}
+
+// waitForTorrent polls the client until it reports the migrated torrent and returns its hash there.
+// The hash qBittorrent identifies a torrent by depends on the version and on whether it has v1
+// and v2 info hashes, so a hybrid can be known by a different hash on the destination.
+func waitForTorrent(ctx context.Context, client *Client, source qbt.Torrent) (string, error) {
+	candidates := migrateHashCandidates(source)
+	for attempt := 0; attempt < migrateVerifyAttempts; attempt++ {
+		torrents, err := client.GetTorrentsCtx(ctx, qbt.TorrentFilterOptions{Hashes: candidates})
+		if err == nil {
+			if hash, found := findMigratedTorrent(torrents, source); found {
+				return hash, nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-time.After(migrateVerifyInterval):
+		}
+	}
+
+	return "", fmt.Errorf("torrent %s not found after %d attempts", source.Hash, migrateVerifyAttempts)
+}
+
+// migrateHashCandidates returns the hashes a migrated torrent can be known by: its hash on the
+// source, its v1 info hash, and its v2 info hash truncated to 40 characters as qBittorrent does
+// for the ID of v2 torrents
+func migrateHashCandidates(torrent qbt.Torrent) []string {
+	var candidates []string
+	for _, hash := range []string{torrent.Hash, torrent.InfohashV1, truncateHash(torrent.InfohashV2)} {
+		hash = strings.ToLower(hash)
+		if hash != "" && !slices.Contains(candidates, hash) {
+			candidates = append(candidates, hash)
+		}
+	}
+	return candidates
+}
+
+// findMigratedTorrent finds the source torrent among the destination's torrents by any of its
+// hashes and returns its hash on the destination
+func findMigratedTorrent(torrents []qbt.Torrent, source qbt.Torrent) (string, bool) {
+	candidates := migrateHashCandidates(source)
+	for _, torrent := range torrents {
+		if slices.Contains(candidates, strings.ToLower(torrent.Hash)) ||
+			(source.InfohashV1 != "" && strings.EqualFold(torrent.InfohashV1, source.InfohashV1)) ||
+			(source.InfohashV2 != "" && strings.EqualFold(torrent.InfohashV2, source.InfohashV2)) {
+			return torrent.Hash, true
+		}
+	}
+	return "", false
+}
+
+func truncateHash(hash string) string {
+	if len(hash) > 40 {
+		return hash[:40]
+	}
+	return hash
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"strings"
+	"testing"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMigrateAddOptions(t *testing.T) {
+	t.Run("preserves placement and tags", func(t *testing.T) {
+		options := migrateAddOptions(qbt.Torrent{
+			SavePath: "/data/movies",
+			Category: "movies",
+			Tags:     "hd, private",
+			State:    qbt.TorrentStateUploading,
+		})
+
+		assert.Equal(t, "/data/movies", options["savepath"])
+		assert.Equal(t, "false", options["autoTMM"])
+		assert.Equal(t, "movies", options["category"])
+		assert.Equal(t, "hd,private", options["tags"])
+		assert.NotContains(t, options, "paused")
+	})
+
+	t.Run("keeps paused torrents paused", func(t *testing.T) {
+		options := migrateAddOptions(qbt.Torrent{SavePath: "/data", State: qbt.TorrentStateStoppedUp})
+
+		assert.Equal(t, "true", options["paused"])
+		assert.Equal(t, "true", options["stopped"])
+		assert.NotContains(t, options, "category")
+		assert.NotContains(t, options, "tags")
+	})
+}
+
+func TestFindMigratedTorrent(t *testing.T) {
+	v1 := strings.Repeat("a", 40)
+	v2 := strings.Repeat("b", 64)
+
+	// A hybrid identified by its v1 hash on the source may be identified by its truncated v2
+	// hash on the destination
+	source := qbt.Torrent{Hash: v1, InfohashV1: v1, InfohashV2: v2}
+	assert.Equal(t, []string{v1, v2[:40]}, migrateHashCandidates(source))
+
+	newHash, found := findMigratedTorrent([]qbt.Torrent{
+		{Hash: strings.Repeat("c", 40)},
+		{Hash: v2[:40], InfohashV2: v2},
+	}, source)
+	assert.True(t, found)
+	assert.Equal(t, v2[:40], newHash)
+
+	newHash, found = findMigratedTorrent([]qbt.Torrent{{Hash: strings.ToUpper(v1)}}, qbt.Torrent{Hash: v1})
+	assert.True(t, found)
+	assert.Equal(t, strings.ToUpper(v1), newHash)
+
+	_, found = findMigratedTorrent([]qbt.Torrent{{Hash: strings.Repeat("c", 40)}}, source)
+	assert.False(t, found)
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+)
+
+// sortTorrentsByNaturalName sorts torrents by name in natural order
+func sortTorrentsByNaturalName(torrents []qbt.Torrent, desc bool) {
+	slices.SortStableFunc(torrents, func(a, b qbt.Torrent) int {
+		if desc {
+			return naturalCompare(b.Name, a.Name)
+		}
+		return naturalCompare(a.Name, b.Name)
+	})
+}
+
+// naturalCompare compares strings case-insensitively, treating runs of digits as numbers so
+// "Episode 2" < "Episode 10". Strings that only differ in case or leading zeros fall back to
+// byte order to keep the result deterministic.
+func naturalCompare(a, b string) int {
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		if isDigit(a[i]) && isDigit(b[j]) {
+			numA, nextI := digitRun(a, i)
+			numB, nextJ := digitRun(b, j)
+			if c := compareNumeric(numA, numB); c != 0 {
+				return c
+			}
+			i, j = nextI, nextJ
+			continue
+		}
+
+		runeA, sizeA := utf8.DecodeRuneInString(a[i:])
+		runeB, sizeB := utf8.DecodeRuneInString(b[j:])
+		if lowerA, lowerB := unicode.ToLower(runeA), unicode.ToLower(runeB); lowerA != lowerB {
+			if lowerA < lowerB {
+				return -1
+			}
+			return 1
+		}
+		i += sizeA
+		j += sizeB
+	}
+
+	switch {
+	case i < len(a):
+		return 1
+	case j < len(b):
+		return -1
+	}
+	return strings.Compare(a, b)
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// digitRun returns the digits starting at start without leading zeros, and the index after them
+func digitRun(s string, start int) (string, int) {
+	end := start
+	for end < len(s) && isDigit(s[end]) {
+		end++
+	}
+	return strings.TrimLeft(s[start:end], "0"), end
+}
+
+// compareNumeric compares unsigned decimal strings without leading zeros, of any length
+func compareNumeric(a, b string) int {
+	if len(a) != len(b) {
+		if len(a) < len(b) {
+			return -1
+		}
+		return 1
+	}
+	return strings.Compare(a, b)
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"testing"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNaturalCompare(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"Episode 2", "Episode 10", -1},
+		{"Episode 10", "Episode 2", 1},
+		{"Episode 2", "Episode 2", 0},
+		{"episode 3", "Episode 20", -1},
+		{"Show.S01E09", "Show.S01E10", -1},
+		{"Show.S02E01", "Show.S10E01", -1},
+		{"file007", "file7b", -1},
+		{"v1.9.2", "v1.10.0", -1},
+		{"abc", "abc1", -1},
+		{"1080p", "720p", 1},
+		{"Movie 99999999999999999999", "Movie 100000000000000000000", -1},
+		{"Ärger 2", "ärger 10", -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+" vs "+tt.b, func(t *testing.T) {
+			assert.Equal(t, tt.want, naturalCompare(tt.a, tt.b))
+		})
+	}
+}
+
+func TestSortTorrentsByNaturalName(t *testing.T) {
+	torrents := []qbt.Torrent{{Name: "Episode 10"}, {Name: "episode 1"}, {Name: "Episode 2"}, {Name: "Bonus"}}
+
+	sortTorrentsByNaturalName(torrents, false)
+	assert.Equal(t, []string{"Bonus", "episode 1", "Episode 2", "Episode 10"}, torrentNames(torrents))
+
+	sortTorrentsByNaturalName(torrents, true)
+	assert.Equal(t, []string{"Episode 10", "Episode 2", "episode 1", "Bonus"}, torrentNames(torrents))
+}
+
+func torrentNames(torrents []qbt.Torrent) []string {
+	names := make([]string, len(torrents))
+	for i, torrent := range torrents {
+		names[i] = torrent.Name
+	}
+	return names
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+const (
+	// DefaultPageSize is the number of torrents returned when a request doesn't set a limit
+	DefaultPageSize = 300
+	// DefaultMaxPageSize caps the number of torrents a single request returns
+	DefaultMaxPageSize = 2000
+)
+
+// SetPageSizeLimits sets the page size used when a torrent list request has no limit and the
+// largest page it may ask for. Non-positive values fall back to the built-in defaults, and a
+// default larger than the maximum is lowered to it.
+func (sm *SyncManager) SetPageSizeLimits(defaultSize, maxSize int) {
+	if maxSize <= 0 {
+		maxSize = DefaultMaxPageSize
+	}
+	if defaultSize <= 0 {
+		defaultSize = DefaultPageSize
+	}
+	sm.maxPageSize.Store(int64(maxSize))
+	sm.defaultPageSize.Store(int64(min(defaultSize, maxSize)))
+}
+
+// PageSize returns the number of torrents a request for limit torrents gets
+func (sm *SyncManager) PageSize(limit int) int {
+	defaultSize, maxSize := sm.defaultPageSize.Load(), sm.maxPageSize.Load()
+	if maxSize <= 0 {
+		defaultSize, maxSize = DefaultPageSize, DefaultMaxPageSize
+	}
+	return clampPageSize(limit, int(defaultSize), int(maxSize))
+}
+
+// clampPageSize applies the default page size to non-positive limits and caps the rest
+func clampPageSize(limit, defaultSize, maxSize int) int {
+	if limit <= 0 {
+		return defaultSize
+	}
+	return min(limit, maxSize)
+}
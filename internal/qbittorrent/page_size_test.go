@@ -0,0 +1,34 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPageSize(t *testing.T) {
+	sm := &SyncManager{}
+
+	assert.Equal(t, DefaultPageSize, sm.PageSize(0), "unset limits use the built-in default")
+	assert.Equal(t, DefaultMaxPageSize, sm.PageSize(1_000_000))
+
+	sm.SetPageSizeLimits(50, 500)
+	assert.Equal(t, 50, sm.PageSize(0))
+	assert.Equal(t, 50, sm.PageSize(-10))
+	assert.Equal(t, 120, sm.PageSize(120))
+	assert.Equal(t, 500, sm.PageSize(501))
+}
+
+func TestSetPageSizeLimitsLowersDefaultToMax(t *testing.T) {
+	sm := &SyncManager{}
+
+	sm.SetPageSizeLimits(1000, 200)
+	assert.Equal(t, 200, sm.PageSize(0))
+
+	sm.SetPageSizeLimits(0, 0)
+	assert.Equal(t, DefaultPageSize, sm.PageSize(0))
+	assert.Equal(t, DefaultMaxPageSize, sm.PageSize(5000))
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+)
+
+// allTorrentsHash is accepted by the WebAPI in place of a hash list to target every torrent
+const allTorrentsHash = "all"
+
+// PauseAll pauses every torrent on the instance and returns how many were running
+func (sm *SyncManager) PauseAll(ctx context.Context, instanceID int) (int, error) {
+	return sm.setAllPaused(ctx, instanceID, true)
+}
+
+// ResumeAll resumes every torrent on the instance and returns how many were paused
+func (sm *SyncManager) ResumeAll(ctx context.Context, instanceID int) (int, error) {
+	return sm.setAllPaused(ctx, instanceID, false)
+}
+
+func (sm *SyncManager) setAllPaused(ctx context.Context, instanceID int, pause bool) (int, error) {
+	client, syncManager, err := sm.getClientAndSyncManager(ctx, instanceID)
+	if err != nil {
+		return 0, err
+	}
+
+	hashes := torrentsToToggle(syncManager.GetTorrents(qbt.TorrentFilterOptions{}), pause)
+	if len(hashes) == 0 {
+		return 0, nil
+	}
+
+	action := "resume"
+	if pause {
+		action = "pause"
+	}
+
+	sm.applyOptimisticCacheUpdate(instanceID, hashes, action, nil)
+
+	// A single global call avoids sending every hash for large instances
+	if pause {
+		err = client.PauseCtx(ctx, []string{allTorrentsHash})
+	} else {
+		err = client.ResumeCtx(ctx, []string{allTorrentsHash})
+	}
+	if err != nil {
+		for _, hash := range hashes {
+			client.clearOptimisticUpdate(hash)
+		}
+		return 0, fmt.Errorf("failed to %s all torrents: %w", action, err)
+	}
+
+	sm.syncAfterModification(instanceID, client, action+"_all")
+
+	return len(hashes), nil
+}
+
+// torrentsToToggle returns the hashes whose state changes when pausing (running torrents) or
+// resuming (paused or stopped torrents)
+func torrentsToToggle(torrents []qbt.Torrent, pause bool) []string {
+	pausedStates := torrentStateCategories[qbt.TorrentFilterPaused]
+
+	var hashes []string
+	for _, torrent := range torrents {
+		if slices.Contains(pausedStates, torrent.State) != pause {
+			hashes = append(hashes, torrent.Hash)
+		}
+	}
+	return hashes
+}
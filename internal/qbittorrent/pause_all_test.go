@@ -0,0 +1,24 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"testing"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTorrentsToToggle(t *testing.T) {
+	torrents := []qbt.Torrent{
+		{Hash: "seeding", State: qbt.TorrentStateUploading},
+		{Hash: "paused", State: qbt.TorrentStatePausedDl},
+		{Hash: "stopped", State: qbt.TorrentStateStoppedUp},
+		{Hash: "stalled", State: qbt.TorrentStateStalledDl},
+	}
+
+	assert.Equal(t, []string{"seeding", "stalled"}, torrentsToToggle(torrents, true))
+	assert.Equal(t, []string{"paused", "stopped"}, torrentsToToggle(torrents, false))
+	assert.Empty(t, torrentsToToggle(nil, true))
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/autobrr/autobrr/pkg/ttlcache"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog/log"
+	"golang.org/x/time/rate"
 
 	"github.com/autobrr/qui/internal/models"
 )
@@ -62,6 +63,7 @@ type ClientPool struct {
 	stopHealth        chan struct{}
 	failureTracker    map[int]*failureInfo
 	decryptionTracker map[int]*decryptionErrorInfo
+	limiters          map[int]*rate.Limiter // Per-instance outbound request limiters
 }
 
 // NewClientPool creates a new client pool
@@ -80,6 +82,7 @@ func NewClientPool(instanceStore *models.InstanceStore, errorStore *models.Insta
 		stopHealth:        make(chan struct{}),
 		failureTracker:    make(map[int]*failureInfo),
 		decryptionTracker: make(map[int]*decryptionErrorInfo),
+		limiters:          make(map[int]*rate.Limiter),
 	}
 
 	// Start health check routine
@@ -204,12 +207,26 @@ func (cp *ClientPool) createClientWithTimeout(ctx context.Context, instanceID in
 		}
 	}
 
+	// Decrypt proxy password if a proxy is configured
+	proxyURL, err := cp.instanceStore.GetProxyURL(instance)
+	if err != nil {
+		if cp.isDecryptionError(err) && cp.shouldLogDecryptionError(instanceID) {
+			log.Error().Err(err).Int("instanceID", instanceID).Str("instanceName", instance.Name).
+				Msg("Failed to decrypt proxy password - likely due to sessionSecret change. Instance will be unavailable until password is re-entered via web UI")
+		}
+		return nil, fmt.Errorf("failed to decrypt proxy password: %w", err)
+	}
+
 	// Create new client with custom timeout
-	client, err := NewClientWithTimeout(instanceID, instance.Host, instance.Username, password, instance.BasicUsername, basicPassword, instance.TLSSkipVerify, timeout)
+	client, err := NewClientWithTimeout(instanceID, instance.Host, instance.Username, password, instance.BasicUsername, basicPassword, instance.TLSSkipVerify, proxyURL, timeout)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create client: %w", err)
 	}
 
+	// Throttle everything after login, including syncs and bulk operations
+	client.setRequestLimiter(cp.requestLimiter(instanceID, instance.RateLimit))
+	client.fuzzySearch = !instance.DisableFuzzySearch
+
 	// Store in pool (need write lock for this)
 	cp.mu.Lock()
 	cp.clients[instanceID] = client
@@ -252,16 +269,21 @@ func (cp *ClientPool) healthCheckLoop() {
 	}
 }
 
-// performHealthChecks checks the health of all clients
-func (cp *ClientPool) performHealthChecks() {
+// connectedClients returns the clients currently in the pool without creating any
+func (cp *ClientPool) connectedClients() []*Client {
 	cp.mu.RLock()
+	defer cp.mu.RUnlock()
+
 	clients := make([]*Client, 0, len(cp.clients))
 	for _, client := range cp.clients {
 		clients = append(clients, client)
 	}
-	cp.mu.RUnlock()
+	return clients
+}
 
-	for _, client := range clients {
+// performHealthChecks checks the health of all clients
+func (cp *ClientPool) performHealthChecks() {
+	for _, client := range cp.connectedClients() {
 		instanceID := client.GetInstanceID()
 
 		// Skip if recently checked
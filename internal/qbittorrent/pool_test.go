@@ -244,3 +244,34 @@ func TestClientPool_IsBanError(t *testing.T) {
 		})
 	}
 }
+
+func TestClientPool_GetInstancesHealth(t *testing.T) {
+	pool := setupTestPool(t)
+	defer pool.Close()
+
+	ctx := t.Context()
+
+	disconnected, err := pool.instanceStore.Create(ctx, "offline", "http://localhost:1", "user", "pass", nil, nil, false, false)
+	require.NoError(t, err)
+	broken, err := pool.instanceStore.Create(ctx, "copied-db", "http://localhost:2", "user", "pass", nil, nil, false, false)
+	require.NoError(t, err)
+
+	require.NoError(t, pool.errorStore.RecordError(ctx, disconnected.ID, errors.New("dial tcp: connection refused")))
+	pool.decryptionTracker[broken.ID] = &decryptionErrorInfo{logged: true}
+
+	health, err := pool.GetInstancesHealth(ctx)
+	require.NoError(t, err)
+	require.Len(t, health, 2)
+
+	byID := make(map[int]InstanceHealth)
+	for _, h := range health {
+		byID[h.ID] = h
+	}
+
+	assert.Equal(t, InstanceHealthDisconnected, byID[disconnected.ID].Status)
+	require.NotNil(t, byID[disconnected.ID].LastError)
+	assert.Equal(t, models.ErrorTypeConnection, byID[disconnected.ID].LastError.ErrorType)
+
+	assert.Equal(t, InstanceHealthDecryptionError, byID[broken.ID].Status)
+	assert.True(t, byID[broken.ID].DecryptionError)
+}
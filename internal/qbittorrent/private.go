@@ -0,0 +1,130 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// privateFlagTTL bounds how long a torrent's private flag is cached. The flag is part of the
+// torrent's info dictionary and never changes, so the TTL only limits memory use.
+const privateFlagTTL = 6 * time.Hour
+
+// PrivateTorrentsError is returned when an action that may break private tracker rules is
+// refused because some of the torrents are private
+type PrivateTorrentsError struct {
+	Action string
+	Hashes []string
+}
+
+func (e *PrivateTorrentsError) Error() string {
+	return fmt.Sprintf("%s refused for %d private torrent(s): %s", e.Action, len(e.Hashes), strings.Join(e.Hashes, ", "))
+}
+
+// IsPrivate reports whether a torrent is from a private tracker
+func (sm *SyncManager) IsPrivate(ctx context.Context, instanceID int, hash string) (bool, error) {
+	client, err := sm.clientPool.GetClient(ctx, instanceID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get client: %w", err)
+	}
+
+	return client.isPrivate(ctx, hash)
+}
+
+// ForceReannounceAll forces an announce to every tracker of the torrents. Repeated forced
+// announces can break private tracker rules, so private torrents are refused unless allowPrivate.
+// Torrents reannounced within the minimum interval are skipped; the skipped count is returned.
+func (sm *SyncManager) ForceReannounceAll(ctx context.Context, instanceID int, hashes []string, allowPrivate bool) (int, error) {
+	client, err := sm.clientPool.GetClient(ctx, instanceID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get client: %w", err)
+	}
+
+	if err := client.guardPrivate(ctx, "forceReannounceAll", hashes, allowPrivate); err != nil {
+		return 0, err
+	}
+
+	// The WebAPI has no per-tracker reannounce; torrents/reannounce already forces an announce
+	// to every tracker in every tier. Sync afterwards so refreshed tracker statuses show up.
+	skipped, err := sm.reannounce(ctx, client, instanceID, hashes)
+	if err != nil {
+		return skipped, fmt.Errorf("failed to reannounce torrents: %w", err)
+	}
+
+	sm.syncAfterModification(instanceID, client, "forceReannounceAll")
+
+	return skipped, nil
+}
+
+// guardPrivate returns a PrivateTorrentsError listing the private torrents among hashes,
+// unless allowPrivate is set
+func (c *Client) guardPrivate(ctx context.Context, action string, hashes []string, allowPrivate bool) error {
+	private, err := c.privateHashes(ctx, hashes)
+	if err != nil {
+		return err
+	}
+
+	if len(private) == 0 {
+		return nil
+	}
+
+	if allowPrivate {
+		log.Warn().Int("instanceID", c.instanceID).Str("action", action).Int("private", len(private)).Msg("Running action on private torrents by explicit override")
+		return nil
+	}
+
+	return &PrivateTorrentsError{Action: action, Hashes: private}
+}
+
+// privateHashes returns the hashes of private torrents
+func (c *Client) privateHashes(ctx context.Context, hashes []string) ([]string, error) {
+	var private []string
+	for _, hash := range hashes {
+		isPrivate, err := c.isPrivate(ctx, hash)
+		if err != nil {
+			return nil, err
+		}
+		if isPrivate {
+			private = append(private, hash)
+		}
+	}
+	return private, nil
+}
+
+// splitPrivate separates public torrents from private ones, keeping the order of hashes
+func (c *Client) splitPrivate(ctx context.Context, hashes []string) (public, private []string, err error) {
+	for _, hash := range hashes {
+		isPrivate, err := c.isPrivate(ctx, hash)
+		if err != nil {
+			return nil, nil, err
+		}
+		if isPrivate {
+			private = append(private, hash)
+		} else {
+			public = append(public, hash)
+		}
+	}
+	return public, private, nil
+}
+
+// isPrivate reports whether a torrent is private. torrents/info does not include the flag on
+// all qBittorrent versions, so it is read from the torrent properties and cached.
+func (c *Client) isPrivate(ctx context.Context, hash string) (bool, error) {
+	if private, ok := c.privateFlags.Get(hash); ok {
+		return private, nil
+	}
+
+	props, err := c.GetTorrentPropertiesCtx(ctx, hash)
+	if err != nil {
+		return false, fmt.Errorf("failed to get torrent properties: %w", err)
+	}
+
+	c.privateFlags.Set(hash, props.IsPrivate, privateFlagTTL)
+	return props.IsPrivate, nil
+}
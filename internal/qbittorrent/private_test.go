@@ -0,0 +1,36 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"testing"
+	"time"
+
+	"github.com/autobrr/autobrr/pkg/ttlcache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_GuardPrivate(t *testing.T) {
+	client := &Client{
+		privateFlags: ttlcache.New(ttlcache.Options[string, bool]{}),
+	}
+	client.privateFlags.Set("public", false, time.Minute)
+	client.privateFlags.Set("private", true, time.Minute)
+
+	assert.NoError(t, client.guardPrivate(t.Context(), "addPeers", []string{"public"}, false))
+
+	err := client.guardPrivate(t.Context(), "addPeers", []string{"public", "private"}, false)
+	var privateErr *PrivateTorrentsError
+	require.ErrorAs(t, err, &privateErr)
+	assert.Equal(t, []string{"private"}, privateErr.Hashes)
+	assert.Equal(t, "addPeers", privateErr.Action)
+
+	assert.NoError(t, client.guardPrivate(t.Context(), "addPeers", []string{"private"}, true), "allowPrivate overrides the guard")
+
+	public, private, err := client.splitPrivate(t.Context(), []string{"private", "public"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"public"}, public)
+	assert.Equal(t, []string{"private"}, private)
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+)
+
+// ErrUnknownTorrentField is returned when a projection names a field qbt.Torrent does not have
+var ErrUnknownTorrentField = errors.New("unknown torrent field")
+
+// torrentFields maps the JSON name of every qbt.Torrent field to its struct field index.
+// It is the whitelist of fields a projection may select.
+var torrentFields = func() map[string]int {
+	fields := make(map[string]int)
+	torrentType := reflect.TypeFor[qbt.Torrent]()
+	for i := range torrentType.NumField() {
+		name, _, _ := strings.Cut(torrentType.Field(i).Tag.Get("json"), ",")
+		if name != "" && name != "-" {
+			fields[name] = i
+		}
+	}
+	return fields
+}()
+
+// ParseTorrentFields parses a comma-separated list of torrent JSON field names, such as
+// "name,size,state". The hash is always included since rows are identified by it. An empty list
+// returns nil, meaning the full torrent.
+func ParseTorrentFields(raw string) ([]string, error) {
+	if strings.TrimSpace(raw) == "" {
+		return nil, nil
+	}
+
+	fields := []string{"hash"}
+	seen := map[string]struct{}{"hash": {}}
+	for field := range strings.SplitSeq(raw, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		if _, ok := torrentFields[field]; !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownTorrentField, field)
+		}
+		if _, ok := seen[field]; ok {
+			continue
+		}
+		seen[field] = struct{}{}
+		fields = append(fields, field)
+	}
+
+	return fields, nil
+}
+
+// ProjectTorrents returns a reduced view of each torrent holding only the given fields, which
+// must come from ParseTorrentFields
+func ProjectTorrents(torrents []qbt.Torrent, fields []string) []map[string]any {
+	projected := make([]map[string]any, len(torrents))
+	for i := range torrents {
+		value := reflect.ValueOf(&torrents[i]).Elem()
+		row := make(map[string]any, len(fields))
+		for _, field := range fields {
+			row[field] = value.Field(torrentFields[field]).Interface()
+		}
+		projected[i] = row
+	}
+	return projected
+}
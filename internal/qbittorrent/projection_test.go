@@ -0,0 +1,39 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"testing"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTorrentFields(t *testing.T) {
+	fields, err := ParseTorrentFields(" name, size,state,name,")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"hash", "name", "size", "state"}, fields)
+
+	fields, err = ParseTorrentFields("")
+	require.NoError(t, err)
+	assert.Nil(t, fields)
+
+	_, err = ParseTorrentFields("name,password")
+	assert.ErrorIs(t, err, ErrUnknownTorrentField)
+}
+
+func TestProjectTorrents(t *testing.T) {
+	torrents := []qbt.Torrent{{Hash: "abc", Name: "Some Release", Size: 42, State: qbt.TorrentStateUploading, Category: "movies"}}
+
+	fields, err := ParseTorrentFields("name,size,state")
+	require.NoError(t, err)
+
+	assert.Equal(t, []map[string]any{{
+		"hash":  "abc",
+		"name":  "Some Release",
+		"size":  int64(42),
+		"state": qbt.TorrentStateUploading,
+	}}, ProjectTorrents(torrents, fields))
+}
@@ -0,0 +1,217 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+)
+
+// ErrTorrentNotFound is returned when a torrent is not in the instance's synced data
+var ErrTorrentNotFound = errors.New("torrent not found")
+
+// ErrTorrentNotQueued is returned when moving a torrent that has no queue position. qBittorrent
+// reports priority 0 for torrents outside the queue, e.g. when queueing is disabled or the
+// torrent is seeding.
+var ErrTorrentNotQueued = errors.New("torrent is not queued")
+
+// SetQueuePosition moves a torrent to an absolute queue position (1 is the top). Positions past
+// the end of the queue move it to the bottom. It returns the position the torrent ends up at.
+func (sm *SyncManager) SetQueuePosition(ctx context.Context, instanceID int, hash string, position int) (int, error) {
+	if position < 1 {
+		return 0, fmt.Errorf("queue position must be at least 1")
+	}
+
+	client, syncManager, err := sm.getClientAndSyncManager(ctx, instanceID)
+	if err != nil {
+		return 0, err
+	}
+
+	torrent, exists := syncManager.GetTorrent(hash)
+	if !exists {
+		return 0, fmt.Errorf("%w: %s", ErrTorrentNotFound, hash)
+	}
+
+	current := int(torrent.Priority)
+	if current <= 0 {
+		return 0, ErrTorrentNotQueued
+	}
+
+	torrents := syncManager.GetTorrents(qbt.TorrentFilterOptions{})
+	queueLength := queueLength(torrents)
+	target := min(position, queueLength)
+	if target == current {
+		return current, nil
+	}
+
+	hashes := []string{hash}
+	switch {
+	case target == 1:
+		err = client.SetMaxPriorityCtx(ctx, hashes)
+	case target == queueLength:
+		err = client.SetMinPriorityCtx(ctx, hashes)
+	case target < current:
+		for range current - target {
+			if err = client.IncreasePriorityCtx(ctx, hashes); err != nil {
+				break
+			}
+		}
+	default:
+		for range target - current {
+			if err = client.DecreasePriorityCtx(ctx, hashes); err != nil {
+				break
+			}
+		}
+	}
+
+	// Sync even after a partial failure so the table shows where the torrent ended up
+	sm.syncAfterModification(instanceID, client, "set_queue_position")
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to move torrent in queue: %w", err)
+	}
+
+	// Reorder the table right away instead of waiting for the sync to report the new positions
+	client.setQueueOverlay(shiftQueuePositions(torrents, hash, current, target))
+
+	return target, nil
+}
+
+// shiftQueuePositions returns the queue positions that change when a torrent moves from one
+// position to another: the torrents in between shift by one towards where it came from
+func shiftQueuePositions(torrents []qbt.Torrent, hash string, from, to int) map[string]int64 {
+	positions := map[string]int64{hash: int64(to)}
+	for _, torrent := range torrents {
+		priority := int(torrent.Priority)
+		switch {
+		case torrent.Hash == hash || priority <= 0:
+		case to < from && priority >= to && priority < from:
+			positions[torrent.Hash] = int64(priority + 1)
+		case to > from && priority > from && priority <= to:
+			positions[torrent.Hash] = int64(priority - 1)
+		}
+	}
+	return positions
+}
+
+// queueOverlay holds the queue positions of a move until a sync reports them
+type queueOverlay struct {
+	positions map[string]int64
+	movedAt   time.Time
+}
+
+// setQueueOverlay replaces the queue positions shown until the next syncs confirm them
+func (c *Client) setQueueOverlay(positions map[string]int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.queueOverlay = &queueOverlay{positions: positions, movedAt: time.Now()}
+}
+
+// applyQueueOverlay applies the positions of the last queue move to the torrents until the
+// backend has synced past it, like optimistic state updates
+func (sm *SyncManager) applyQueueOverlay(client *Client, lastSyncTime time.Time, torrents []qbt.Torrent) {
+	client.mu.Lock()
+	overlay := client.queueOverlay
+	timeout := sm.getOptimisticUpdateTimeout()
+	if overlay != nil && (syncedPastOptimisticUpdate(overlay.movedAt, lastSyncTime, timeout) || time.Since(overlay.movedAt) > timeout) {
+		client.queueOverlay = nil
+		overlay = nil
+	}
+	client.mu.Unlock()
+
+	if overlay == nil {
+		return
+	}
+	for i := range torrents {
+		if priority, ok := overlay.positions[torrents[i].Hash]; ok {
+			torrents[i].Priority = priority
+		}
+	}
+}
+
+// queueLength returns the lowest queue position in use
+func queueLength(torrents []qbt.Torrent) int {
+	length := 0
+	for _, torrent := range torrents {
+		length = max(length, int(torrent.Priority))
+	}
+	return length
+}
+
+var (
+	// ErrQueueingDisabled is returned when setting queue limits while torrent queueing is off,
+	// since qBittorrent ignores the limits then
+	ErrQueueingDisabled = errors.New("torrent queueing is disabled")
+	// ErrInvalidQueueLimits is returned for negative queue limits other than -1 (unlimited)
+	ErrInvalidQueueLimits = errors.New("invalid queue limits")
+)
+
+// QueueLimits are the maximum numbers of active torrents qBittorrent's queue allows.
+// -1 means unlimited.
+type QueueLimits struct {
+	QueueingEnabled    bool `json:"queueingEnabled"`
+	MaxActiveDownloads int  `json:"maxActiveDownloads"`
+	MaxActiveUploads   int  `json:"maxActiveUploads"`
+	MaxActiveTorrents  int  `json:"maxActiveTorrents"`
+}
+
+// GetQueueLimits returns the queue limits of an instance
+func (sm *SyncManager) GetQueueLimits(ctx context.Context, instanceID int) (*QueueLimits, error) {
+	prefs, err := sm.GetAppPreferences(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &QueueLimits{
+		QueueingEnabled:    prefs.QueueingEnabled,
+		MaxActiveDownloads: prefs.MaxActiveDownloads,
+		MaxActiveUploads:   prefs.MaxActiveUploads,
+		MaxActiveTorrents:  prefs.MaxActiveTorrents,
+	}, nil
+}
+
+// SetQueueLimits sets the maximum active downloads, uploads and torrents of an instance,
+// leaving every other preference untouched. Queueing must be enabled on the instance.
+func (sm *SyncManager) SetQueueLimits(ctx context.Context, instanceID int, maxActiveDownloads, maxActiveUploads, maxActiveTorrents int) (*QueueLimits, error) {
+	if err := validateQueueLimits(maxActiveDownloads, maxActiveUploads, maxActiveTorrents); err != nil {
+		return nil, err
+	}
+
+	current, err := sm.GetQueueLimits(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	if !current.QueueingEnabled {
+		return nil, ErrQueueingDisabled
+	}
+
+	prefs := map[string]any{
+		"max_active_downloads": maxActiveDownloads,
+		"max_active_uploads":   maxActiveUploads,
+		"max_active_torrents":  maxActiveTorrents,
+	}
+	if err := sm.SetAppPreferences(ctx, instanceID, prefs); err != nil {
+		return nil, err
+	}
+
+	return &QueueLimits{
+		QueueingEnabled:    true,
+		MaxActiveDownloads: maxActiveDownloads,
+		MaxActiveUploads:   maxActiveUploads,
+		MaxActiveTorrents:  maxActiveTorrents,
+	}, nil
+}
+
+func validateQueueLimits(limits ...int) error {
+	for _, limit := range limits {
+		if limit < -1 {
+			return fmt.Errorf("%w: limits must be non-negative, or -1 for unlimited", ErrInvalidQueueLimits)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"testing"
+	"time"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueueLength(t *testing.T) {
+	torrents := []qbt.Torrent{
+		{Hash: "a", Priority: 2},
+		{Hash: "b", Priority: 0},
+		{Hash: "c", Priority: 3},
+		{Hash: "d", Priority: 1},
+	}
+
+	assert.Equal(t, 3, queueLength(torrents))
+	assert.Equal(t, 0, queueLength(nil))
+	assert.Equal(t, 0, queueLength([]qbt.Torrent{{Hash: "seeding", Priority: 0}}))
+}
+
+func TestShiftQueuePositions(t *testing.T) {
+	torrents := []qbt.Torrent{
+		{Hash: "a", Priority: 1},
+		{Hash: "b", Priority: 2},
+		{Hash: "c", Priority: 3},
+		{Hash: "d", Priority: 4},
+		{Hash: "seeding", Priority: 0},
+	}
+
+	assert.Equal(t, map[string]int64{"d": 2, "b": 3, "c": 4}, shiftQueuePositions(torrents, "d", 4, 2), "Moving up shifts the torrents in between down")
+	assert.Equal(t, map[string]int64{"a": 3, "b": 1, "c": 2}, shiftQueuePositions(torrents, "a", 1, 3), "Moving down shifts the torrents in between up")
+}
+
+func TestApplyQueueOverlay(t *testing.T) {
+	sm := &SyncManager{}
+	client := &Client{}
+	client.setQueueOverlay(map[string]int64{"a": 2, "b": 1})
+
+	torrents := []qbt.Torrent{{Hash: "a", Priority: 1}, {Hash: "b", Priority: 2}}
+	sm.applyQueueOverlay(client, time.Now(), torrents)
+	assert.Equal(t, int64(2), torrents[0].Priority)
+	assert.Equal(t, int64(1), torrents[1].Priority)
+
+	// Once the backend has synced past the move its positions are used again
+	torrents = []qbt.Torrent{{Hash: "a", Priority: 1}}
+	sm.applyQueueOverlay(client, time.Now().Add(time.Minute), torrents)
+	assert.Equal(t, int64(1), torrents[0].Priority)
+	assert.Nil(t, client.queueOverlay)
+}
+
+func TestValidateQueueLimits(t *testing.T) {
+	assert.NoError(t, validateQueueLimits(3, 0, -1))
+	assert.ErrorIs(t, validateQueueLimits(3, -2, 5), ErrInvalidQueueLimits)
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"math"
+	"net/http"
+
+	"golang.org/x/time/rate"
+)
+
+// rateLimitedTransport delays outbound requests until the instance's token bucket allows them.
+// Waiting honours the request context, so cancelled operations stop queueing immediately.
+type rateLimitedTransport struct {
+	base    http.RoundTripper
+	limiter *rate.Limiter
+}
+
+func (t *rateLimitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+	return t.base.RoundTrip(req)
+}
+
+// setRequestLimiter routes all further requests of the client through limiter
+func (c *Client) setRequestLimiter(limiter *rate.Limiter) {
+	httpClient := c.GetHTTPClient()
+	if transport, ok := httpClient.Transport.(*rateLimitedTransport); ok {
+		transport.limiter = limiter
+		return
+	}
+
+	base := httpClient.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	httpClient.Transport = &rateLimitedTransport{base: base, limiter: limiter}
+}
+
+// requestLimiter returns the instance's token bucket, configured for requestsPerSecond. The
+// limiter outlives individual clients so reconnecting doesn't refill the bucket.
+func (cp *ClientPool) requestLimiter(instanceID int, requestsPerSecond float64) *rate.Limiter {
+	limit, burst := requestRate(requestsPerSecond)
+
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+
+	limiter, ok := cp.limiters[instanceID]
+	if !ok {
+		limiter = rate.NewLimiter(limit, burst)
+		cp.limiters[instanceID] = limiter
+		return limiter
+	}
+
+	if limiter.Limit() != limit || limiter.Burst() != burst {
+		limiter.SetLimit(limit)
+		limiter.SetBurst(burst)
+	}
+	return limiter
+}
+
+// requestRate converts a requests-per-second setting to a token bucket rate and burst.
+// Non-positive rates are unlimited; otherwise up to one second of requests may burst.
+func requestRate(requestsPerSecond float64) (rate.Limit, int) {
+	if requestsPerSecond <= 0 {
+		return rate.Inf, 1
+	}
+	return rate.Limit(requestsPerSecond), max(1, int(math.Ceil(requestsPerSecond)))
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestRequestRate(t *testing.T) {
+	limit, burst := requestRate(0)
+	assert.Equal(t, rate.Inf, limit)
+	assert.Equal(t, 1, burst)
+
+	limit, burst = requestRate(2.5)
+	assert.Equal(t, rate.Limit(2.5), limit)
+	assert.Equal(t, 3, burst)
+
+	limit, burst = requestRate(0.2)
+	assert.Equal(t, rate.Limit(0.2), limit)
+	assert.Equal(t, 1, burst)
+}
+
+func TestClientPool_RequestLimiterReconfigures(t *testing.T) {
+	cp := &ClientPool{limiters: make(map[int]*rate.Limiter)}
+
+	limiter := cp.requestLimiter(1, 5)
+	assert.Equal(t, rate.Limit(5), limiter.Limit())
+
+	same := cp.requestLimiter(1, 1)
+	assert.Same(t, limiter, same, "limiter should be reused across clients")
+	assert.Equal(t, rate.Limit(1), same.Limit())
+	assert.Equal(t, 1, same.Burst())
+}
+
+func TestRateLimitedTransport_HonoursCancellation(t *testing.T) {
+	calls := 0
+	transport := &rateLimitedTransport{
+		base: roundTripFunc(func(*http.Request) (*http.Response, error) {
+			calls++
+			return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+		}),
+		limiter: rate.NewLimiter(rate.Every(time.Hour), 1),
+	}
+
+	req, err := http.NewRequest(http.MethodGet, "http://localhost/api/v2/app/version", nil)
+	require.NoError(t, err)
+
+	resp, err := transport.RoundTrip(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err = transport.RoundTrip(req.WithContext(ctx))
+	assert.Error(t, err, "second request should not wait an hour for a token")
+	assert.Equal(t, 1, calls)
+}
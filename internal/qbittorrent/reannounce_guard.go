@@ -0,0 +1,117 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// DefaultReannounceMinInterval is how long a torrent must wait between manual reannounces
+// when not configured. Trackers throttle or ban clients that announce too often.
+const DefaultReannounceMinInterval = 2 * time.Minute
+
+// reannounceGuard remembers when torrents were last reannounced so repeated clicks don't
+// hammer trackers. State is kept in memory only; a restart clears it.
+type reannounceGuard struct {
+	mu       sync.Mutex
+	interval time.Duration
+	last     map[int]map[string]time.Time // instance ID -> hash -> last reannounce
+}
+
+func newReannounceGuard() *reannounceGuard {
+	return &reannounceGuard{
+		interval: DefaultReannounceMinInterval,
+		last:     make(map[int]map[string]time.Time),
+	}
+}
+
+func (g *reannounceGuard) setInterval(interval time.Duration) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.interval = max(interval, 0)
+}
+
+// allow splits hashes into those that may be reannounced now and the number skipped because
+// they were reannounced within the interval. Allowed hashes are recorded as reannounced at now.
+func (g *reannounceGuard) allow(instanceID int, hashes []string, now time.Time) ([]string, int) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.interval <= 0 {
+		return hashes, 0
+	}
+
+	last := g.last[instanceID]
+	if last == nil {
+		last = make(map[string]time.Time)
+		g.last[instanceID] = last
+	}
+
+	// Drop expired entries so the map only holds torrents inside the window
+	for hash, at := range last {
+		if now.Sub(at) >= g.interval {
+			delete(last, hash)
+		}
+	}
+
+	allowed := make([]string, 0, len(hashes))
+	for _, hash := range hashes {
+		if _, recent := last[hash]; recent {
+			continue
+		}
+		last[hash] = now
+		allowed = append(allowed, hash)
+	}
+
+	return allowed, len(hashes) - len(allowed)
+}
+
+// forget clears the records of hashes whose reannounce failed so they can be retried at once
+func (g *reannounceGuard) forget(instanceID int, hashes []string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for _, hash := range hashes {
+		delete(g.last[instanceID], hash)
+	}
+}
+
+// SetReannounceMinInterval sets the minimum time between reannounces of the same torrent.
+// Zero disables the guard.
+func (sm *SyncManager) SetReannounceMinInterval(interval time.Duration) {
+	sm.reannounces.setInterval(interval)
+}
+
+// Reannounce reannounces the torrents to their trackers, skipping torrents reannounced within
+// the minimum interval. It returns how many torrents were skipped.
+func (sm *SyncManager) Reannounce(ctx context.Context, instanceID int, hashes []string) (int, error) {
+	client, err := sm.clientPool.GetClient(ctx, instanceID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get client: %w", err)
+	}
+
+	return sm.reannounce(ctx, client, instanceID, hashes)
+}
+
+func (sm *SyncManager) reannounce(ctx context.Context, client *Client, instanceID int, hashes []string) (int, error) {
+	allowed, skipped := sm.reannounces.allow(instanceID, hashes, time.Now())
+	if skipped > 0 {
+		log.Debug().Int("instanceID", instanceID).Int("skipped", skipped).Msg("Skipped torrents reannounced within the minimum interval")
+	}
+	if len(allowed) == 0 {
+		return skipped, nil
+	}
+
+	if err := client.ReAnnounceTorrentsCtx(ctx, allowed); err != nil {
+		sm.reannounces.forget(instanceID, allowed)
+		return skipped, err
+	}
+
+	return skipped, nil
+}
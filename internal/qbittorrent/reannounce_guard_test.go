@@ -0,0 +1,57 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReannounceGuardAllow(t *testing.T) {
+	guard := newReannounceGuard()
+	guard.setInterval(time.Minute)
+	now := time.Now()
+
+	allowed, skipped := guard.allow(1, []string{"a", "b"}, now)
+	assert.Equal(t, []string{"a", "b"}, allowed)
+	assert.Zero(t, skipped)
+
+	allowed, skipped = guard.allow(1, []string{"a", "c"}, now.Add(30*time.Second))
+	assert.Equal(t, []string{"c"}, allowed)
+	assert.Equal(t, 1, skipped)
+
+	// Other instances are tracked separately
+	allowed, skipped = guard.allow(2, []string{"a"}, now.Add(30*time.Second))
+	assert.Equal(t, []string{"a"}, allowed)
+	assert.Zero(t, skipped)
+
+	allowed, skipped = guard.allow(1, []string{"a", "b", "c"}, now.Add(time.Minute))
+	assert.Equal(t, []string{"a", "b"}, allowed)
+	assert.Equal(t, 1, skipped)
+}
+
+func TestReannounceGuardForget(t *testing.T) {
+	guard := newReannounceGuard()
+	now := time.Now()
+
+	guard.allow(1, []string{"a"}, now)
+	guard.forget(1, []string{"a"})
+
+	allowed, skipped := guard.allow(1, []string{"a"}, now)
+	assert.Equal(t, []string{"a"}, allowed)
+	assert.Zero(t, skipped)
+}
+
+func TestReannounceGuardDisabled(t *testing.T) {
+	guard := newReannounceGuard()
+	guard.setInterval(0)
+	now := time.Now()
+
+	guard.allow(1, []string{"a"}, now)
+	allowed, skipped := guard.allow(1, []string{"a"}, now)
+	assert.Equal(t, []string{"a"}, allowed)
+	assert.Zero(t, skipped)
+}
@@ -0,0 +1,262 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"sync"
+	"time"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+	"github.com/rs/zerolog/log"
+)
+
+// Statuses of torrents in a recheck queue, alongside RecheckPending, RecheckIncomplete and
+// RecheckMissing
+const (
+	RecheckQueued    = "queued"    // Waiting for an earlier batch to finish
+	RecheckComplete  = "complete"  // Checked and complete
+	RecheckCancelled = "cancelled" // Not checked because the queue was cancelled
+)
+
+// ErrRecheckQueueRunning is returned when starting a recheck queue while one is still running
+// on the instance
+var ErrRecheckQueueRunning = errors.New("a recheck queue is already running on this instance")
+
+// RecheckQueueStatus is the progress of a recheck queue
+type RecheckQueueStatus struct {
+	InstanceID    int               `json:"instanceId"`
+	MaxConcurrent int               `json:"maxConcurrent"`
+	Statuses      map[string]string `json:"statuses"`
+	Done          bool              `json:"done"`
+	Error         string            `json:"error,omitempty"`
+	StartedAt     time.Time         `json:"startedAt"`
+	FinishedAt    *time.Time        `json:"finishedAt,omitempty"`
+}
+
+// recheckQueue is a running or finished recheck queue of an instance
+type recheckQueue struct {
+	mu     sync.Mutex
+	status RecheckQueueStatus
+	cancel context.CancelFunc
+}
+
+func (q *recheckQueue) update(statuses map[string]string) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.status.Statuses = maps.Clone(statuses)
+}
+
+func (q *recheckQueue) finish(err error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	now := time.Now()
+	q.status.Done = true
+	q.status.FinishedAt = &now
+	if err != nil {
+		q.status.Error = err.Error()
+	}
+}
+
+func (q *recheckQueue) snapshot() *RecheckQueueStatus {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	status := q.status
+	status.Statuses = maps.Clone(q.status.Statuses)
+	return &status
+}
+
+// StartRecheckQueue rechecks the torrents in the background, maxConcurrent at a time, so bulk
+// rechecks don't thrash the disk. Only one queue runs per instance; the last queue's status
+// stays available until the next one starts.
+func (sm *SyncManager) StartRecheckQueue(instanceID int, hashes []string, maxConcurrent int) (*RecheckQueueStatus, error) {
+	if len(hashes) == 0 {
+		return nil, fmt.Errorf("no torrents to recheck")
+	}
+	maxConcurrent = max(maxConcurrent, 1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	queue := &recheckQueue{
+		status: RecheckQueueStatus{
+			InstanceID:    instanceID,
+			MaxConcurrent: maxConcurrent,
+			Statuses:      initialRecheckStatuses(hashes),
+			StartedAt:     time.Now(),
+		},
+		cancel: cancel,
+	}
+
+	for {
+		existing, loaded := sm.recheckQueues.LoadOrStore(instanceID, queue)
+		if !loaded {
+			break
+		}
+		previous := existing.(*recheckQueue)
+		if !previous.snapshot().Done {
+			cancel()
+			return nil, ErrRecheckQueueRunning
+		}
+		if sm.recheckQueues.CompareAndSwap(instanceID, previous, queue) {
+			break
+		}
+	}
+
+	go func() {
+		defer cancel()
+		_, err := sm.QueuedRecheck(ctx, instanceID, hashes, maxConcurrent, queue.update)
+		if err != nil {
+			log.Warn().Err(err).Int("instanceID", instanceID).Msg("Recheck queue stopped")
+		}
+		queue.finish(err)
+	}()
+
+	return queue.snapshot(), nil
+}
+
+// GetRecheckQueue returns the status of the instance's current or last recheck queue, or nil
+// if none was started
+func (sm *SyncManager) GetRecheckQueue(instanceID int) *RecheckQueueStatus {
+	queue, ok := sm.recheckQueues.Load(instanceID)
+	if !ok {
+		return nil
+	}
+	return queue.(*recheckQueue).snapshot()
+}
+
+// CancelRecheckQueue stops the instance's recheck queue after the current poll. Torrents
+// already checking finish their check; queued ones are not started.
+func (sm *SyncManager) CancelRecheckQueue(instanceID int) bool {
+	queue, ok := sm.recheckQueues.Load(instanceID)
+	if !ok {
+		return false
+	}
+	queue.(*recheckQueue).cancel()
+	return true
+}
+
+// QueuedRecheck rechecks the torrents in batches of maxConcurrent, starting each batch once
+// every torrent of the previous one has left the checking state. onProgress, if set, receives
+// the status of every hash whenever it changes. It returns the final status of every hash;
+// when ctx is cancelled, torrents that were not started are marked cancelled.
+func (sm *SyncManager) QueuedRecheck(ctx context.Context, instanceID int, hashes []string, maxConcurrent int, onProgress func(map[string]string)) (map[string]string, error) {
+	client, syncManager, err := sm.getClientAndSyncManager(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := initialRecheckStatuses(hashes)
+	report := func() {
+		if onProgress != nil {
+			onProgress(statuses)
+		}
+	}
+
+	for _, batch := range recheckBatches(hashes, maxConcurrent) {
+		if err := ctx.Err(); err != nil {
+			cancelQueuedRechecks(statuses)
+			report()
+			return statuses, err
+		}
+
+		existing := syncManager.GetTorrentMap(qbt.TorrentFilterOptions{Hashes: batch})
+		var present []string
+		for _, hash := range batch {
+			if _, ok := existing[hash]; ok {
+				present = append(present, hash)
+			} else {
+				statuses[hash] = RecheckMissing
+			}
+		}
+		if len(present) == 0 {
+			report()
+			continue
+		}
+
+		if err := client.RecheckCtx(ctx, present); err != nil {
+			return statuses, fmt.Errorf("failed to recheck torrents: %w", err)
+		}
+		sm.applyOptimisticCacheUpdate(instanceID, present, "recheck", nil)
+		for _, hash := range present {
+			statuses[hash] = RecheckPending
+		}
+		report()
+
+		if err := sm.waitForRecheckBatch(ctx, instanceID, syncManager, present, statuses, report); err != nil {
+			cancelQueuedRechecks(statuses)
+			report()
+			return statuses, err
+		}
+	}
+
+	sm.syncAfterModification(instanceID, client, "queued_recheck")
+
+	return statuses, nil
+}
+
+// waitForRecheckBatch polls until no torrent of the batch is checking anymore, recording the
+// outcome of each torrent as it finishes
+func (sm *SyncManager) waitForRecheckBatch(ctx context.Context, instanceID int, syncManager *qbt.SyncManager, batch []string, statuses map[string]string, report func()) error {
+	started := time.Now()
+	seenChecking := make(map[string]bool, len(batch))
+
+	ticker := time.NewTicker(recheckPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+
+		if err := syncManager.Sync(ctx); err != nil {
+			log.Warn().Err(err).Int("instanceID", instanceID).Msg("Failed to sync while waiting for recheck batch")
+			continue
+		}
+
+		torrents := syncManager.GetTorrentMap(qbt.TorrentFilterOptions{Hashes: batch})
+		outcomes, pending := classifyRecheck(batch, torrents, seenChecking, time.Since(started) >= recheckStartGrace)
+		for hash, outcome := range outcomes {
+			if outcome == RecheckResumed {
+				outcome = RecheckComplete
+			}
+			statuses[hash] = outcome
+		}
+		report()
+
+		if !pending {
+			return nil
+		}
+	}
+}
+
+func initialRecheckStatuses(hashes []string) map[string]string {
+	statuses := make(map[string]string, len(hashes))
+	for _, hash := range hashes {
+		statuses[hash] = RecheckQueued
+	}
+	return statuses
+}
+
+// cancelQueuedRechecks marks torrents that were never started as cancelled
+func cancelQueuedRechecks(statuses map[string]string) {
+	for hash, status := range statuses {
+		if status == RecheckQueued {
+			statuses[hash] = RecheckCancelled
+		}
+	}
+}
+
+// recheckBatches splits hashes into consecutive batches of at most size hashes
+func recheckBatches(hashes []string, size int) [][]string {
+	size = max(size, 1)
+	batches := make([][]string, 0, (len(hashes)+size-1)/size)
+	for start := 0; start < len(hashes); start += size {
+		batches = append(batches, hashes[start:min(start+size, len(hashes))])
+	}
+	return batches
+}
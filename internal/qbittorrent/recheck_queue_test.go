@@ -0,0 +1,47 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecheckBatches(t *testing.T) {
+	hashes := []string{"a", "b", "c", "d", "e"}
+
+	assert.Equal(t, [][]string{{"a", "b"}, {"c", "d"}, {"e"}}, recheckBatches(hashes, 2))
+	assert.Equal(t, [][]string{{"a", "b", "c", "d", "e"}}, recheckBatches(hashes, 10))
+	assert.Len(t, recheckBatches(hashes, 0), 5, "non-positive sizes recheck one at a time")
+	assert.Empty(t, recheckBatches(nil, 2))
+}
+
+func TestCancelQueuedRechecks(t *testing.T) {
+	statuses := map[string]string{
+		"a": RecheckComplete,
+		"b": RecheckPending,
+		"c": RecheckQueued,
+	}
+
+	cancelQueuedRechecks(statuses)
+
+	assert.Equal(t, map[string]string{
+		"a": RecheckComplete,
+		"b": RecheckPending,
+		"c": RecheckCancelled,
+	}, statuses)
+}
+
+func TestStartRecheckQueueRejectsConcurrentQueue(t *testing.T) {
+	sm := &SyncManager{}
+	running := &recheckQueue{status: RecheckQueueStatus{InstanceID: 1}, cancel: func() {}}
+	sm.recheckQueues.Store(1, running)
+
+	_, err := sm.StartRecheckQueue(1, []string{"a"}, 1)
+	assert.ErrorIs(t, err, ErrRecheckQueueRunning)
+
+	queue, _ := sm.recheckQueues.Load(1)
+	assert.Same(t, running, queue, "the running queue is kept")
+}
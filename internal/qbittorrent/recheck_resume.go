@@ -0,0 +1,160 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+	"github.com/rs/zerolog/log"
+)
+
+// Outcomes of RecheckAndResumeIfComplete for each torrent
+const (
+	RecheckResumed    = "resumed"    // Complete after the recheck and resumed
+	RecheckIncomplete = "incomplete" // Not complete after the recheck, left paused
+	RecheckPending    = "checking"   // Still checking when the wait ran out, left paused
+	RecheckMissing    = "missing"    // Not on the instance
+)
+
+const (
+	// recheckPollInterval is how often torrents are synced while waiting for a recheck
+	recheckPollInterval = 2 * time.Second
+	// recheckStartGrace is how long a torrent that was never seen checking is assumed to be
+	// queued for checking rather than done
+	recheckStartGrace = 10 * time.Second
+)
+
+// RecheckAndResumeIfComplete pauses and rechecks the torrents, waits up to maxWait for the
+// checks to finish and then resumes only the torrents that came back complete. Incomplete
+// torrents stay paused so nothing is downloaded again after data was moved by hand. It returns
+// the outcome for every requested hash.
+func (sm *SyncManager) RecheckAndResumeIfComplete(ctx context.Context, instanceID int, hashes []string, maxWait time.Duration) (map[string]string, error) {
+	client, syncManager, err := sm.getClientAndSyncManager(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := sm.validateTorrentsExist(client, hashes, "recheck and resume"); err != nil {
+		return nil, err
+	}
+
+	// Pause first, otherwise qBittorrent resumes running torrents after the check whatever the result
+	if err := client.PauseCtx(ctx, hashes); err != nil {
+		return nil, fmt.Errorf("failed to pause torrents: %w", err)
+	}
+
+	sm.applyOptimisticCacheUpdate(instanceID, hashes, "recheck", nil)
+
+	if err := client.RecheckCtx(ctx, hashes); err != nil {
+		for _, hash := range hashes {
+			client.clearOptimisticUpdate(hash)
+		}
+		return nil, fmt.Errorf("failed to recheck torrents: %w", err)
+	}
+
+	started := time.Now()
+	seenChecking := make(map[string]bool, len(hashes))
+
+	outcomes, err := pollTorrentOutcomes(ctx, instanceID, syncManager, recheckPollInterval, maxWait, "recheck", func(now time.Time) (map[string]string, bool) {
+		torrents := syncManager.GetTorrentMap(qbt.TorrentFilterOptions{Hashes: hashes})
+		return classifyRecheck(hashes, torrents, seenChecking, now.Sub(started) >= recheckStartGrace)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var complete []string
+	for _, hash := range hashes {
+		if outcomes[hash] == RecheckResumed {
+			complete = append(complete, hash)
+		}
+	}
+
+	if len(complete) > 0 {
+		if err := client.ResumeCtx(ctx, complete); err != nil {
+			return nil, fmt.Errorf("failed to resume complete torrents: %w", err)
+		}
+		sm.applyOptimisticCacheUpdate(instanceID, complete, "resume", nil)
+	}
+
+	sm.syncAfterModification(instanceID, client, "recheck_and_resume")
+
+	return outcomes, nil
+}
+
+// pollTorrentOutcomes syncs the instance every interval and classifies the torrents until none are
+// pending or maxWait is up, and returns the last outcomes. Failed syncs count against maxWait as
+// well, so an unreachable instance doesn't hold the request open; if no sync succeeded by the
+// deadline the last sync error is returned.
+func pollTorrentOutcomes(ctx context.Context, instanceID int, syncManager *qbt.SyncManager, interval, maxWait time.Duration, waitingFor string, classify func(now time.Time) (map[string]string, bool)) (map[string]string, error) {
+	deadline := time.Now().Add(maxWait)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var outcomes map[string]string
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+
+		err := syncManager.Sync(ctx)
+		now := time.Now()
+		if err != nil {
+			log.Warn().Err(err).Int("instanceID", instanceID).Msgf("Failed to sync while waiting for %s", waitingFor)
+			if now.Before(deadline) {
+				continue
+			}
+			if outcomes == nil {
+				return nil, fmt.Errorf("timed out waiting for %s: %w", waitingFor, err)
+			}
+			return outcomes, nil
+		}
+
+		var pending bool
+		outcomes, pending = classify(now)
+		if !pending || !now.Before(deadline) {
+			return outcomes, nil
+		}
+	}
+}
+
+// classifyRecheck works out the outcome of every torrent and whether any are still checking.
+// seenChecking records torrents observed in a checking state. Until graceOver, a torrent that was
+// never seen checking is treated as queued for checking, since right after the recheck request
+// qBittorrent may still report the torrent's previous state.
+func classifyRecheck(hashes []string, torrents map[string]qbt.Torrent, seenChecking map[string]bool, graceOver bool) (map[string]string, bool) {
+	checkingStates := torrentStateCategories[qbt.TorrentFilterChecking]
+
+	outcomes := make(map[string]string, len(hashes))
+	pending := false
+	for _, hash := range hashes {
+		torrent, exists := torrents[hash]
+		switch {
+		case !exists:
+			outcomes[hash] = RecheckMissing
+			continue
+		case slices.Contains(checkingStates, torrent.State):
+			seenChecking[hash] = true
+			outcomes[hash] = RecheckPending
+		case !seenChecking[hash] && !graceOver:
+			outcomes[hash] = RecheckPending
+		case torrent.Progress >= 1:
+			outcomes[hash] = RecheckResumed
+		default:
+			outcomes[hash] = RecheckIncomplete
+		}
+
+		if outcomes[hash] == RecheckPending {
+			pending = true
+		}
+	}
+
+	return outcomes, pending
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClassifyRecheck(t *testing.T) {
+	hashes := []string{"complete", "partial", "checking", "missing"}
+	seen := map[string]bool{}
+
+	torrents := map[string]qbt.Torrent{
+		"complete": {State: qbt.TorrentStatePausedUp, Progress: 1},
+		"partial":  {State: qbt.TorrentStatePausedDl, Progress: 0.4},
+		"checking": {State: qbt.TorrentStateCheckingUp, Progress: 0.7},
+	}
+
+	// Before the grace period, torrents not seen checking yet may not have started
+	outcomes, pending := classifyRecheck(hashes, torrents, seen, false)
+	assert.True(t, pending)
+	assert.Equal(t, map[string]string{
+		"complete": RecheckPending,
+		"partial":  RecheckPending,
+		"checking": RecheckPending,
+		"missing":  RecheckMissing,
+	}, outcomes)
+
+	torrents["checking"] = qbt.Torrent{State: qbt.TorrentStatePausedDl, Progress: 0.9}
+
+	// A torrent seen checking is done as soon as it leaves the checking state
+	outcomes, pending = classifyRecheck(hashes, torrents, seen, false)
+	assert.True(t, pending)
+	assert.Equal(t, RecheckIncomplete, outcomes["checking"])
+
+	outcomes, pending = classifyRecheck(hashes, torrents, seen, true)
+	assert.False(t, pending)
+	assert.Equal(t, map[string]string{
+		"complete": RecheckResumed,
+		"partial":  RecheckIncomplete,
+		"checking": RecheckIncomplete,
+		"missing":  RecheckMissing,
+	}, outcomes)
+}
+
+func TestPollTorrentOutcomesUnreachable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	syncManager := qbt.NewClient(qbt.Config{Host: server.URL}).NewSyncManager(qbt.DefaultSyncOptions())
+	classified := false
+
+	start := time.Now()
+	outcomes, err := pollTorrentOutcomes(context.Background(), 1, syncManager, 10*time.Millisecond, 50*time.Millisecond, "recheck", func(time.Time) (map[string]string, bool) {
+		classified = true
+		return nil, true
+	})
+
+	require.Error(t, err, "the wait ends even though no sync succeeds")
+	assert.Nil(t, outcomes)
+	assert.False(t, classified)
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
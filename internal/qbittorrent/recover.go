@@ -0,0 +1,59 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+)
+
+// RecoverErrored rechecks and then resumes every torrent in an error state (errored or missing
+// files), e.g. after a disk was briefly unavailable. It returns the hashes it attempted.
+func (sm *SyncManager) RecoverErrored(ctx context.Context, instanceID int) ([]string, error) {
+	client, syncManager, err := sm.getClientAndSyncManager(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	hashes := erroredHashes(syncManager.GetTorrents(qbt.TorrentFilterOptions{}))
+	if len(hashes) == 0 {
+		return []string{}, nil
+	}
+
+	// Show the torrents as checking right away; the resume is queued behind the recheck
+	sm.applyOptimisticCacheUpdate(instanceID, hashes, "recheck", nil)
+
+	if err := client.RecheckCtx(ctx, hashes); err != nil {
+		for _, hash := range hashes {
+			client.clearOptimisticUpdate(hash)
+		}
+		return nil, fmt.Errorf("failed to recheck errored torrents: %w", err)
+	}
+
+	if err := client.ResumeCtx(ctx, hashes); err != nil {
+		sm.syncAfterModification(instanceID, client, "recover_errored")
+		return nil, fmt.Errorf("failed to resume rechecked torrents: %w", err)
+	}
+
+	sm.syncAfterModification(instanceID, client, "recover_errored")
+
+	return hashes, nil
+}
+
+// erroredHashes returns the hashes of torrents in an error state
+func erroredHashes(torrents []qbt.Torrent) []string {
+	errorStates := torrentStateCategories[qbt.TorrentFilterError]
+
+	hashes := []string{}
+	for _, torrent := range torrents {
+		if slices.Contains(errorStates, torrent.State) {
+			hashes = append(hashes, torrent.Hash)
+		}
+	}
+	slices.Sort(hashes)
+	return hashes
+}
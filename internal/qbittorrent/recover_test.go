@@ -0,0 +1,23 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"testing"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErroredHashes(t *testing.T) {
+	torrents := []qbt.Torrent{
+		{Hash: "ok", State: qbt.TorrentStateUploading},
+		{Hash: "missing", State: qbt.TorrentStateMissingFiles},
+		{Hash: "errored", State: qbt.TorrentStateError},
+		{Hash: "paused", State: qbt.TorrentStatePausedDl},
+	}
+
+	assert.Equal(t, []string{"errored", "missing"}, erroredHashes(torrents))
+	assert.Empty(t, erroredHashes(nil))
+}
@@ -0,0 +1,310 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/autobrr/autobrr/pkg/ttlcache"
+	"github.com/rs/zerolog/log"
+)
+
+// searchJobIdleTimeout is how long a search job may go unread before it is deleted on qBittorrent
+const searchJobIdleTimeout = 10 * time.Minute
+
+// Search job statuses reported by qBittorrent
+const (
+	SearchStatusRunning = "Running"
+	SearchStatusStopped = "Stopped"
+)
+
+// SearchPlugin represents an installed qBittorrent search engine plugin
+type SearchPlugin struct {
+	Enabled             bool             `json:"enabled"`
+	FullName            string           `json:"fullName"`
+	Name                string           `json:"name"`
+	SupportedCategories []SearchCategory `json:"supportedCategories"`
+	URL                 string           `json:"url"`
+	Version             string           `json:"version"`
+}
+
+// SearchCategory represents a category supported by a search plugin
+type SearchCategory struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// SearchResult represents a single result returned by a search plugin
+type SearchResult struct {
+	DescrLink  string `json:"descrLink"`
+	FileName   string `json:"fileName"`
+	FileSize   int64  `json:"fileSize"`
+	FileURL    string `json:"fileUrl"`
+	NbLeechers int    `json:"nbLeechers"`
+	NbSeeders  int    `json:"nbSeeders"`
+	SiteURL    string `json:"siteUrl"`
+}
+
+// SearchResults represents a page of results and the progress of a search job
+type SearchResults struct {
+	ID      int            `json:"id"`
+	Status  string         `json:"status"`
+	Total   int            `json:"total"`
+	Results []SearchResult `json:"results"`
+}
+
+// SearchJob represents a search started through qui
+type SearchJob struct {
+	ID         int       `json:"id"`
+	InstanceID int       `json:"instanceId"`
+	Pattern    string    `json:"pattern"`
+	Category   string    `json:"category"`
+	Plugins    string    `json:"plugins"`
+	StartedAt  time.Time `json:"startedAt"`
+}
+
+type searchJobKey struct {
+	instanceID int
+	id         int
+}
+
+// newSearchJobCache creates the tracker for running search jobs. Jobs that are not read for
+// searchJobIdleTimeout are deleted on qBittorrent so abandoned searches don't pile up.
+func (sm *SyncManager) newSearchJobCache() *ttlcache.Cache[searchJobKey, *SearchJob] {
+	return ttlcache.New(ttlcache.Options[searchJobKey, *SearchJob]{}.
+		SetDefaultTTL(searchJobIdleTimeout).
+		SetDeallocationFunc(func(key searchJobKey, _ *SearchJob, reason ttlcache.DeallocationReason) {
+			if reason != ttlcache.ReasonTimedOut {
+				return
+			}
+			// Called with the cache locked, so clean up asynchronously
+			go func() {
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+
+				client, err := sm.clientPool.GetClient(ctx, key.instanceID)
+				if err != nil {
+					return
+				}
+				if err := client.deleteSearchCtx(ctx, key.id); err != nil {
+					log.Debug().Err(err).Int("instanceID", key.instanceID).Int("searchID", key.id).Msg("Failed to delete idle search job")
+					return
+				}
+				log.Debug().Int("instanceID", key.instanceID).Int("searchID", key.id).Msg("Deleted idle search job")
+			}()
+		}))
+}
+
+// GetSearchPlugins gets the installed search plugins of an instance
+func (sm *SyncManager) GetSearchPlugins(ctx context.Context, instanceID int) ([]SearchPlugin, error) {
+	client, err := sm.clientPool.GetClient(ctx, instanceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client: %w", err)
+	}
+
+	var plugins []SearchPlugin
+	if err := client.searchRequestCtx(ctx, "plugins", nil, &plugins); err != nil {
+		return nil, fmt.Errorf("failed to get search plugins: %w", err)
+	}
+
+	return plugins, nil
+}
+
+// StartSearch starts a search job on an instance. plugins may be "all", "enabled" or
+// plugin names separated by "|"; category defaults to "all".
+func (sm *SyncManager) StartSearch(ctx context.Context, instanceID int, pattern, category, plugins string) (*SearchJob, error) {
+	if strings.TrimSpace(pattern) == "" {
+		return nil, fmt.Errorf("search pattern is required")
+	}
+	if category == "" {
+		category = "all"
+	}
+	if plugins == "" {
+		plugins = "enabled"
+	}
+
+	client, err := sm.clientPool.GetClient(ctx, instanceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client: %w", err)
+	}
+
+	var started struct {
+		ID int `json:"id"`
+	}
+	params := url.Values{
+		"pattern":  {pattern},
+		"category": {category},
+		"plugins":  {plugins},
+	}
+	if err := client.searchRequestCtx(ctx, "start", params, &started); err != nil {
+		return nil, fmt.Errorf("failed to start search: %w", err)
+	}
+
+	job := &SearchJob{
+		ID:         started.ID,
+		InstanceID: instanceID,
+		Pattern:    pattern,
+		Category:   category,
+		Plugins:    plugins,
+		StartedAt:  time.Now(),
+	}
+	sm.searchJobs.Set(searchJobKey{instanceID: instanceID, id: job.ID}, job, searchJobIdleTimeout)
+
+	return job, nil
+}
+
+// GetSearchResults gets a page of results and the current status of a search job
+func (sm *SyncManager) GetSearchResults(ctx context.Context, instanceID, searchID, limit, offset int) (*SearchResults, error) {
+	client, err := sm.clientPool.GetClient(ctx, instanceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client: %w", err)
+	}
+
+	params := url.Values{"id": {strconv.Itoa(searchID)}}
+	if limit > 0 {
+		params.Set("limit", strconv.Itoa(limit))
+	}
+	if offset != 0 {
+		params.Set("offset", strconv.Itoa(offset))
+	}
+
+	results := &SearchResults{ID: searchID}
+	if err := client.searchRequestCtx(ctx, "results", params, results); err != nil {
+		return nil, fmt.Errorf("failed to get search results: %w", err)
+	}
+	if results.Results == nil {
+		results.Results = []SearchResult{}
+	}
+
+	// Reading results keeps the job alive; jobs started elsewhere are adopted so they get cleaned up too
+	key := searchJobKey{instanceID: instanceID, id: searchID}
+	if _, ok := sm.searchJobs.Get(key); !ok {
+		sm.searchJobs.Set(key, &SearchJob{ID: searchID, InstanceID: instanceID, StartedAt: time.Now()}, searchJobIdleTimeout)
+	}
+
+	return results, nil
+}
+
+// GetSearchJobs lists the search jobs qui is tracking for an instance
+func (sm *SyncManager) GetSearchJobs(instanceID int) []*SearchJob {
+	jobs := []*SearchJob{}
+	for _, key := range sm.searchJobs.GetKeys() {
+		if key.instanceID != instanceID {
+			continue
+		}
+		if job, ok := sm.searchJobs.Get(key); ok {
+			jobs = append(jobs, job)
+		}
+	}
+	return jobs
+}
+
+// StopSearch stops a search job and deletes it on qBittorrent
+func (sm *SyncManager) StopSearch(ctx context.Context, instanceID, searchID int) error {
+	client, err := sm.clientPool.GetClient(ctx, instanceID)
+	if err != nil {
+		return fmt.Errorf("failed to get client: %w", err)
+	}
+
+	// Stopping an already finished search is not an error worth surfacing
+	if err := client.searchRequestCtx(ctx, "stop", url.Values{"id": {strconv.Itoa(searchID)}}, nil); err != nil {
+		log.Debug().Err(err).Int("instanceID", instanceID).Int("searchID", searchID).Msg("Failed to stop search job")
+	}
+
+	if err := client.deleteSearchCtx(ctx, searchID); err != nil {
+		return fmt.Errorf("failed to delete search: %w", err)
+	}
+
+	sm.searchJobs.Delete(searchJobKey{instanceID: instanceID, id: searchID})
+
+	return nil
+}
+
+// deleteSearchCtx deletes a search job and its results on qBittorrent
+func (c *Client) deleteSearchCtx(ctx context.Context, searchID int) error {
+	return c.searchRequestCtx(ctx, "delete", url.Values{"id": {strconv.Itoa(searchID)}}, nil)
+}
+
+// searchRequestCtx calls a /api/v2/search endpoint, which go-qbittorrent does not wrap, reusing
+// the session cookie of the underlying client. The response is decoded into out when non-nil.
+func (c *Client) searchRequestCtx(ctx context.Context, endpoint string, params url.Values, out any) error {
+	resp, err := c.doAPIRequestWithRelogin(ctx, "search/"+endpoint, params)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusConflict:
+		return fmt.Errorf("search engine is busy or the search limit was reached")
+	case http.StatusNotFound:
+		return fmt.Errorf("search job not found")
+	default:
+		return unexpectedStatusError(resp)
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return nil
+}
+
+// doAPIRequestWithRelogin is doAPIRequest for endpoints go-qbittorrent does not wrap. When the
+// session has expired it logs in again and retries the request once.
+func (c *Client) doAPIRequestWithRelogin(ctx context.Context, endpoint string, params url.Values) (*http.Response, error) {
+	resp, err := c.doAPIRequest(ctx, endpoint, params)
+	if err != nil || resp.StatusCode != http.StatusForbidden {
+		return resp, err
+	}
+
+	resp.Body.Close()
+	if err := c.LoginCtx(ctx); err != nil {
+		return nil, fmt.Errorf("failed to re-login: %w", err)
+	}
+	return c.doAPIRequest(ctx, endpoint, params)
+}
+
+// unexpectedStatusError describes a response with a status the caller doesn't handle, including
+// the start of its body
+func unexpectedStatusError(resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+}
+
+// doAPIRequest posts form params to a /api/v2 endpoint with the session cookie of the
+// underlying client
+func (c *Client) doAPIRequest(ctx context.Context, endpoint string, params url.Values) (*http.Response, error) {
+	reqURL := strings.TrimRight(c.host, "/") + "/api/v2/" + endpoint
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, reqURL, strings.NewReader(params.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	if c.basicUser != "" {
+		req.SetBasicAuth(c.basicUser, c.basicPass)
+	}
+
+	resp, err := c.GetHTTPClient().Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_SearchRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		switch r.URL.Path {
+		case "/api/v2/search/results":
+			assert.Equal(t, "42", r.PostForm.Get("id"))
+			w.Write([]byte(`{"status":"Running","total":1,"results":[{"fileName":"Ubuntu","fileSize":100,"fileUrl":"magnet:?xt=abc","nbSeeders":5}]}`))
+		case "/api/v2/search/start":
+			w.WriteHeader(http.StatusConflict)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := &Client{
+		Client: qbt.NewClient(qbt.Config{Host: server.URL}),
+		host:   server.URL,
+	}
+
+	t.Run("decodes results", func(t *testing.T) {
+		results := &SearchResults{ID: 42}
+		err := client.searchRequestCtx(context.Background(), "results", url.Values{"id": {"42"}}, results)
+		require.NoError(t, err)
+
+		assert.Equal(t, SearchStatusRunning, results.Status)
+		assert.Equal(t, 1, results.Total)
+		require.Len(t, results.Results, 1)
+		assert.Equal(t, "magnet:?xt=abc", results.Results[0].FileURL)
+		assert.Equal(t, 5, results.Results[0].NbSeeders)
+	})
+
+	t.Run("maps conflict to busy error", func(t *testing.T) {
+		err := client.searchRequestCtx(context.Background(), "start", url.Values{"pattern": {"ubuntu"}}, nil)
+		assert.ErrorContains(t, err, "search limit")
+	})
+
+	t.Run("maps not found", func(t *testing.T) {
+		err := client.deleteSearchCtx(context.Background(), 7)
+		assert.ErrorContains(t, err, "not found")
+	})
+}
+
+func TestClient_DoAPIRequestWithRelogin(t *testing.T) {
+	loggedIn := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/auth/login":
+			loggedIn = true
+			w.Write([]byte("Ok."))
+		case "/api/v2/search/status":
+			if !loggedIn {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			w.Write([]byte(`[]`))
+		}
+	}))
+	defer server.Close()
+
+	client := &Client{
+		Client: qbt.NewClient(qbt.Config{Host: server.URL, Username: "admin", Password: "secret"}),
+		host:   server.URL,
+	}
+
+	resp, err := client.doAPIRequestWithRelogin(context.Background(), "search/status", nil)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.True(t, loggedIn, "An expired session should trigger a login")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
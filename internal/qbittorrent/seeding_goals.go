@@ -0,0 +1,166 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"context"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+)
+
+const (
+	// DefaultSeedingGoalsNearestLimit is the number of torrents closest to their goal GetSeedingGoals returns
+	DefaultSeedingGoalsNearestLimit = 10
+	// seedingGoalCloseProgress is the goal progress from which a torrent counts as close
+	seedingGoalCloseProgress = 0.8
+)
+
+// shareLimitNone is qBittorrent's share limit value for no limit. Per-torrent limits of -2 use
+// the global limit.
+const shareLimitNone = -1
+
+// SeedingGoals buckets the completed torrents of an instance by how far they are from the share
+// limits that stop them seeding. Torrents without a ratio or seeding time limit count as NoGoal.
+type SeedingGoals struct {
+	Met    int `json:"met"`
+	Close  int `json:"close"` // At least 80% of the way to a goal
+	Far    int `json:"far"`
+	NoGoal int `json:"noGoal"`
+	// Nearest are the torrents that haven't met their goal yet, closest first
+	Nearest []SeedingGoalTorrent `json:"nearest"`
+}
+
+// SeedingGoalTorrent is a torrent's progress toward its share limits. Goals are -1 when the
+// torrent has no limit of that kind.
+type SeedingGoalTorrent struct {
+	Hash            string  `json:"hash"`
+	Name            string  `json:"name"`
+	Ratio           float64 `json:"ratio"`
+	RatioGoal       float64 `json:"ratioGoal"`
+	SeedingTime     int64   `json:"seedingTime"`     // Seconds
+	SeedingTimeGoal int64   `json:"seedingTimeGoal"` // Seconds
+	Progress        float64 `json:"progress"`        // Toward whichever goal is reached first, 1 when met
+}
+
+// shareGoals are the limits a torrent stops seeding at, -1 for none
+type shareGoals struct {
+	ratio       float64
+	seedingTime int64 // Seconds
+}
+
+// GetSeedingGoals compares the ratio and seeding time of every completed torrent of an instance
+// against its share limits, using the instance's global limits where a torrent has no override
+func (sm *SyncManager) GetSeedingGoals(ctx context.Context, instanceID int, limit int) (*SeedingGoals, error) {
+	if limit <= 0 {
+		limit = DefaultSeedingGoalsNearestLimit
+	}
+
+	prefs, err := sm.GetAppPreferences(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	torrents, err := sm.getAllTorrentsForStats(ctx, instanceID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	global := shareGoals{ratio: shareLimitNone, seedingTime: shareLimitNone}
+	if prefs.MaxRatioEnabled {
+		global.ratio = prefs.MaxRatio
+	}
+	if prefs.MaxSeedingTimeEnabled {
+		global.seedingTime = int64(prefs.MaxSeedingTime) * 60
+	}
+
+	return computeSeedingGoals(torrents, global, limit), nil
+}
+
+func computeSeedingGoals(torrents []qbt.Torrent, global shareGoals, limit int) *SeedingGoals {
+	goals := &SeedingGoals{}
+	pending := make([]qbt.Torrent, 0, len(torrents))
+	progress := make(map[string]float64)
+
+	for _, torrent := range torrents {
+		if torrent.Progress < 1 {
+			continue
+		}
+
+		goal := torrentShareGoals(&torrent, global)
+		p, ok := goalProgress(&torrent, goal)
+		switch {
+		case !ok:
+			goals.NoGoal++
+			continue
+		case p >= 1:
+			goals.Met++
+			continue
+		case p >= seedingGoalCloseProgress:
+			goals.Close++
+		default:
+			goals.Far++
+		}
+		pending = append(pending, torrent)
+		progress[torrent.Hash] = p
+	}
+
+	nearest := selectTopTorrents(pending, func(t *qbt.Torrent) float64 { return progress[t.Hash] }, limit)
+	goals.Nearest = make([]SeedingGoalTorrent, len(nearest))
+	for i, torrent := range nearest {
+		goal := torrentShareGoals(&torrent, global)
+		goals.Nearest[i] = SeedingGoalTorrent{
+			Hash:            torrent.Hash,
+			Name:            torrent.Name,
+			Ratio:           torrent.Ratio,
+			RatioGoal:       goal.ratio,
+			SeedingTime:     torrent.SeedingTime,
+			SeedingTimeGoal: goal.seedingTime,
+			Progress:        progress[torrent.Hash],
+		}
+	}
+
+	return goals
+}
+
+// torrentShareGoals resolves a torrent's share limits, falling back to the global limits where
+// the torrent uses them. Per-torrent seeding time limits are in minutes.
+func torrentShareGoals(torrent *qbt.Torrent, global shareGoals) shareGoals {
+	goal := global
+	switch {
+	case torrent.RatioLimit == shareLimitNone:
+		goal.ratio = shareLimitNone
+	case torrent.RatioLimit >= 0:
+		goal.ratio = torrent.RatioLimit
+	}
+	switch {
+	case torrent.SeedingTimeLimit == shareLimitNone:
+		goal.seedingTime = shareLimitNone
+	case torrent.SeedingTimeLimit >= 0:
+		goal.seedingTime = torrent.SeedingTimeLimit * 60
+	}
+	return goal
+}
+
+// goalProgress returns how far a torrent is toward the goal it reaches first. qBittorrent stops
+// a torrent once either limit is hit, so the larger of the two fractions counts.
+func goalProgress(torrent *qbt.Torrent, goal shareGoals) (float64, bool) {
+	progress, ok := 0.0, false
+	if goal.ratio >= 0 {
+		ok = true
+		progress = max(progress, fraction(torrent.Ratio, goal.ratio))
+	}
+	if goal.seedingTime >= 0 {
+		ok = true
+		progress = max(progress, fraction(float64(torrent.SeedingTime), float64(goal.seedingTime)))
+	}
+	return min(progress, 1), ok
+}
+
+// fraction returns value/goal, treating a zero goal as already met
+func fraction(value, goal float64) float64 {
+	if goal <= 0 {
+		return 1
+	}
+	return value / goal
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"testing"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeSeedingGoals(t *testing.T) {
+	global := shareGoals{ratio: 2, seedingTime: shareLimitNone}
+	torrents := []qbt.Torrent{
+		{Hash: "met", Name: "met", Progress: 1, Ratio: 2.5, RatioLimit: -2, SeedingTimeLimit: -2},
+		{Hash: "close", Name: "close", Progress: 1, Ratio: 1.8, RatioLimit: -2, SeedingTimeLimit: -2},
+		{Hash: "far", Name: "far", Progress: 1, Ratio: 0.5, RatioLimit: -2, SeedingTimeLimit: -2},
+		// Own seeding time limit of 100 minutes, 95 seeded: close even though the ratio is far
+		{Hash: "time", Name: "time", Progress: 1, Ratio: 0.1, RatioLimit: -2, SeedingTimeLimit: 100, SeedingTime: 95 * 60},
+		{Hash: "unlimited", Name: "unlimited", Progress: 1, Ratio: 9, RatioLimit: -1, SeedingTimeLimit: -2},
+		{Hash: "downloading", Name: "downloading", Progress: 0.5, Ratio: 3, RatioLimit: -2, SeedingTimeLimit: -2},
+	}
+
+	goals := computeSeedingGoals(torrents, global, 10)
+
+	assert.Equal(t, 1, goals.Met)
+	assert.Equal(t, 2, goals.Close)
+	assert.Equal(t, 1, goals.Far)
+	assert.Equal(t, 1, goals.NoGoal)
+
+	require.Len(t, goals.Nearest, 3)
+	assert.Equal(t, "time", goals.Nearest[0].Hash)
+	assert.InDelta(t, 0.95, goals.Nearest[0].Progress, 0.001)
+	assert.Equal(t, int64(6000), goals.Nearest[0].SeedingTimeGoal)
+	assert.Equal(t, "close", goals.Nearest[1].Hash)
+	assert.Equal(t, "far", goals.Nearest[2].Hash)
+	assert.Equal(t, int64(-1), goals.Nearest[2].SeedingTimeGoal)
+}
+
+func TestTorrentShareGoalsOverrides(t *testing.T) {
+	global := shareGoals{ratio: 2, seedingTime: 3600}
+
+	assert.Equal(t, global, torrentShareGoals(&qbt.Torrent{RatioLimit: -2, SeedingTimeLimit: -2}, global))
+	assert.Equal(t, shareGoals{ratio: 1, seedingTime: shareLimitNone}, torrentShareGoals(&qbt.Torrent{RatioLimit: 1, SeedingTimeLimit: -1}, global))
+}
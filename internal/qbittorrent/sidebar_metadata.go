@@ -0,0 +1,141 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"context"
+	"fmt"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+	"github.com/rs/zerolog/log"
+
+	"github.com/autobrr/qui/internal/models"
+)
+
+// SidebarCategory is a qBittorrent category with the color and order qui stores for it
+type SidebarCategory struct {
+	qbt.Category
+	models.SidebarItemMetadata
+}
+
+// SetSidebarMetadataStore sets where the colors and order of categories and tags are stored, so
+// the torrent list and counts can include them
+func (sm *SyncManager) SetSidebarMetadataStore(store *models.SidebarMetadataStore) {
+	sm.sidebarMetadata = store
+}
+
+// GetSidebarMetadata returns the category and tag metadata of an instance. The metadata rarely
+// changes but is read on every torrent list poll, so it is cached until it is set or deleted.
+func (sm *SyncManager) GetSidebarMetadata(ctx context.Context, instanceID int) (*models.SidebarMetadata, error) {
+	if sm.sidebarMetadata == nil {
+		return &models.SidebarMetadata{
+			Categories: map[string]models.SidebarItemMetadata{},
+			Tags:       map[string]models.SidebarItemMetadata{},
+		}, nil
+	}
+
+	sm.sidebarCacheMu.RLock()
+	metadata, ok := sm.sidebarCache[instanceID]
+	gen := sm.sidebarCacheGen
+	sm.sidebarCacheMu.RUnlock()
+	if ok {
+		return metadata, nil
+	}
+
+	metadata, err := sm.sidebarMetadata.Get(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	sm.cacheSidebarMetadata(instanceID, gen, metadata)
+	return metadata, nil
+}
+
+// cacheSidebarMetadata caches metadata read at generation gen. A change made while the rows were
+// read may not be in them, so those are left to the next read.
+func (sm *SyncManager) cacheSidebarMetadata(instanceID int, gen uint64, metadata *models.SidebarMetadata) {
+	sm.sidebarCacheMu.Lock()
+	defer sm.sidebarCacheMu.Unlock()
+
+	if gen != sm.sidebarCacheGen {
+		return
+	}
+	if sm.sidebarCache == nil {
+		sm.sidebarCache = make(map[int]*models.SidebarMetadata)
+	}
+	sm.sidebarCache[instanceID] = metadata
+}
+
+// SetSidebarItemMetadata stores the color and order of a category or tag
+func (sm *SyncManager) SetSidebarItemMetadata(ctx context.Context, instanceID int, kind, name string, metadata models.SidebarItemMetadata) (models.SidebarItemMetadata, error) {
+	if sm.sidebarMetadata == nil {
+		return metadata, fmt.Errorf("sidebar metadata store not configured")
+	}
+
+	defer sm.invalidateSidebarMetadata(instanceID)
+	return sm.sidebarMetadata.Set(ctx, instanceID, kind, name, metadata)
+}
+
+// DeleteSidebarItemMetadata removes the metadata of a category or tag
+func (sm *SyncManager) DeleteSidebarItemMetadata(ctx context.Context, instanceID int, kind, name string) error {
+	if sm.sidebarMetadata == nil {
+		return fmt.Errorf("sidebar metadata store not configured")
+	}
+
+	defer sm.invalidateSidebarMetadata(instanceID)
+	return sm.sidebarMetadata.Delete(ctx, instanceID, kind, name)
+}
+
+// invalidateSidebarMetadata drops the cached metadata of an instance after a change. Bumping the
+// generation keeps reads that started before the change from caching what they read.
+func (sm *SyncManager) invalidateSidebarMetadata(instanceID int) {
+	sm.sidebarCacheMu.Lock()
+	defer sm.sidebarCacheMu.Unlock()
+	sm.sidebarCacheGen++
+	delete(sm.sidebarCache, instanceID)
+}
+
+// loadSidebarMetadata returns the metadata of an instance, or nil when it can't be loaded. The
+// metadata is cosmetic, so the torrent list and counts are still returned without it.
+func (sm *SyncManager) loadSidebarMetadata(ctx context.Context, instanceID int) *models.SidebarMetadata {
+	metadata, err := sm.GetSidebarMetadata(ctx, instanceID)
+	if err != nil {
+		log.Warn().Err(err).Int("instanceID", instanceID).Msg("Failed to load sidebar metadata")
+		return nil
+	}
+	return metadata
+}
+
+// mergeSidebarCategories pairs categories with their metadata
+func mergeSidebarCategories(categories map[string]qbt.Category, metadata *models.SidebarMetadata) map[string]SidebarCategory {
+	merged := make(map[string]SidebarCategory, len(categories))
+	for name, category := range categories {
+		item := SidebarCategory{Category: category}
+		if metadata != nil {
+			item.SidebarItemMetadata = metadata.Categories[name]
+		}
+		merged[name] = item
+	}
+	return merged
+}
+
+// attachSidebarMetadata adds the metadata of the counted categories and tags to the counts
+func attachSidebarMetadata(counts *TorrentCounts, metadata *models.SidebarMetadata) {
+	if counts == nil || metadata == nil {
+		return
+	}
+	counts.CategoryMetadata = countedMetadata(counts.Categories, metadata.Categories)
+	counts.TagMetadata = countedMetadata(counts.Tags, metadata.Tags)
+}
+
+// countedMetadata returns the metadata of the names in counted
+func countedMetadata(counted map[string]int, metadata map[string]models.SidebarItemMetadata) map[string]models.SidebarItemMetadata {
+	result := make(map[string]models.SidebarItemMetadata)
+	for name, item := range metadata {
+		if _, ok := counted[name]; ok {
+			result[name] = item
+		}
+	}
+	return result
+}
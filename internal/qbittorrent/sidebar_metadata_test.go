@@ -0,0 +1,104 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	_ "modernc.org/sqlite"
+
+	"github.com/autobrr/qui/internal/models"
+)
+
+func TestMergeSidebarCategories(t *testing.T) {
+	color, order := "#ff0000", 2
+	categories := map[string]qbt.Category{
+		"movies": {Name: "movies", SavePath: "/data/movies"},
+		"tv":     {Name: "tv", SavePath: "/data/tv"},
+	}
+	metadata := &models.SidebarMetadata{
+		Categories: map[string]models.SidebarItemMetadata{
+			"movies":  {Color: &color, Order: &order},
+			"removed": {Color: &color},
+		},
+		Tags: map[string]models.SidebarItemMetadata{
+			"hd": {Order: &order},
+		},
+	}
+
+	merged := mergeSidebarCategories(categories, metadata)
+	assert.Equal(t, map[string]SidebarCategory{
+		"movies": {Category: categories["movies"], SidebarItemMetadata: models.SidebarItemMetadata{Color: &color, Order: &order}},
+		"tv":     {Category: categories["tv"]},
+	}, merged, "metadata of categories that no longer exist is left out")
+
+	assert.Equal(t, map[string]SidebarCategory{
+		"movies": {Category: categories["movies"]},
+		"tv":     {Category: categories["tv"]},
+	}, mergeSidebarCategories(categories, nil))
+
+	counts := &TorrentCounts{
+		Categories: map[string]int{"movies": 3, "tv": 1},
+		Tags:       map[string]int{"hd": 2, "sd": 1},
+	}
+	attachSidebarMetadata(counts, metadata)
+	assert.Equal(t, map[string]models.SidebarItemMetadata{"movies": {Color: &color, Order: &order}}, counts.CategoryMetadata)
+	assert.Equal(t, map[string]models.SidebarItemMetadata{"hd": {Order: &order}}, counts.TagMetadata)
+}
+
+func TestGetSidebarMetadataCache(t *testing.T) {
+	db, err := sql.Open("sqlite", ":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.Exec(`CREATE TABLE sidebar_metadata (
+		instance_id INTEGER NOT NULL,
+		kind TEXT NOT NULL,
+		name TEXT NOT NULL,
+		color TEXT,
+		display_order INTEGER,
+		updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		PRIMARY KEY (instance_id, kind, name)
+	)`)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	sm := NewSyncManager(nil)
+	sm.SetSidebarMetadataStore(models.NewSidebarMetadataStore(db))
+
+	metadata, err := sm.GetSidebarMetadata(ctx, 1)
+	require.NoError(t, err)
+	assert.Empty(t, metadata.Categories)
+
+	color := "#1e90ff"
+	_, err = sm.SetSidebarItemMetadata(ctx, 1, models.SidebarKindCategory, "movies", models.SidebarItemMetadata{Color: &color})
+	require.NoError(t, err)
+
+	metadata, err = sm.GetSidebarMetadata(ctx, 1)
+	require.NoError(t, err)
+	require.Contains(t, metadata.Categories, "movies", "setting metadata invalidates the cache")
+
+	cached, err := sm.GetSidebarMetadata(ctx, 1)
+	require.NoError(t, err)
+	assert.Same(t, metadata, cached, "reads are served from the cache")
+
+	require.NoError(t, sm.DeleteSidebarItemMetadata(ctx, 1, models.SidebarKindCategory, "movies"))
+	metadata, err = sm.GetSidebarMetadata(ctx, 1)
+	require.NoError(t, err)
+	assert.Empty(t, metadata.Categories, "deleting metadata invalidates the cache")
+
+	// A read that started before a change doesn't cache the rows it read
+	stale := &models.SidebarMetadata{}
+	gen := sm.sidebarCacheGen
+	sm.invalidateSidebarMetadata(1)
+	sm.cacheSidebarMetadata(1, gen, stale)
+	metadata, err = sm.GetSidebarMetadata(ctx, 1)
+	require.NoError(t, err)
+	assert.NotSame(t, stale, metadata)
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidSpeedLimits is returned for negative speed limits
+var ErrInvalidSpeedLimits = errors.New("invalid speed limits")
+
+// SpeedLimits are the download and upload caps of an instance in KB/s. 0 means unlimited.
+type SpeedLimits struct {
+	DownloadLimitKBs int64 `json:"downloadLimitKBs"`
+	UploadLimitKBs   int64 `json:"uploadLimitKBs"`
+}
+
+// GetGlobalSpeedLimits returns the global speed limits of an instance
+func (sm *SyncManager) GetGlobalSpeedLimits(ctx context.Context, instanceID int) (*SpeedLimits, error) {
+	prefs, err := sm.GetAppPreferences(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return newSpeedLimits(int64(prefs.DlLimit), int64(prefs.UpLimit)), nil
+}
+
+// SetGlobalSpeedLimits sets the global speed limits of an instance, leaving every other
+// preference untouched
+func (sm *SyncManager) SetGlobalSpeedLimits(ctx context.Context, instanceID int, downKBs, upKBs int64) (*SpeedLimits, error) {
+	return sm.setSpeedLimits(ctx, instanceID, "dl_limit", "up_limit", downKBs, upKBs)
+}
+
+// GetAlternativeSpeedLimits returns the speed limits an instance applies in alternative speed mode
+func (sm *SyncManager) GetAlternativeSpeedLimits(ctx context.Context, instanceID int) (*SpeedLimits, error) {
+	prefs, err := sm.GetAppPreferences(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return newSpeedLimits(int64(prefs.AltDlLimit), int64(prefs.AltUpLimit)), nil
+}
+
+// SetAlternativeSpeedLimits sets the speed limits of alternative speed mode without toggling it
+func (sm *SyncManager) SetAlternativeSpeedLimits(ctx context.Context, instanceID int, downKBs, upKBs int64) (*SpeedLimits, error) {
+	return sm.setSpeedLimits(ctx, instanceID, "alt_dl_limit", "alt_up_limit", downKBs, upKBs)
+}
+
+func (sm *SyncManager) setSpeedLimits(ctx context.Context, instanceID int, downKey, upKey string, downKBs, upKBs int64) (*SpeedLimits, error) {
+	if downKBs < 0 || upKBs < 0 {
+		return nil, fmt.Errorf("%w: limits must be non-negative, or 0 for unlimited", ErrInvalidSpeedLimits)
+	}
+
+	// Convert KB/s to bytes/s (qBittorrent API expects bytes/s)
+	prefs := map[string]any{
+		downKey: downKBs * 1024,
+		upKey:   upKBs * 1024,
+	}
+	if err := sm.SetAppPreferences(ctx, instanceID, prefs); err != nil {
+		return nil, err
+	}
+
+	return &SpeedLimits{DownloadLimitKBs: downKBs, UploadLimitKBs: upKBs}, nil
+}
+
+// newSpeedLimits converts limits in bytes/s to KB/s. qBittorrent reports unlimited as 0 or,
+// in some versions, -1.
+func newSpeedLimits(downBytes, upBytes int64) *SpeedLimits {
+	return &SpeedLimits{
+		DownloadLimitKBs: max(downBytes, 0) / 1024,
+		UploadLimitKBs:   max(upBytes, 0) / 1024,
+	}
+}
@@ -0,0 +1,27 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSpeedLimits(t *testing.T) {
+	assert.Equal(t, &SpeedLimits{DownloadLimitKBs: 512, UploadLimitKBs: 100}, newSpeedLimits(512*1024, 100*1024))
+	assert.Equal(t, &SpeedLimits{}, newSpeedLimits(0, -1), "unlimited is reported as 0")
+}
+
+func TestSetSpeedLimitsRejectsNegative(t *testing.T) {
+	sm := &SyncManager{}
+
+	_, err := sm.SetGlobalSpeedLimits(context.Background(), 1, -1, 0)
+	require.ErrorIs(t, err, ErrInvalidSpeedLimits)
+
+	_, err = sm.SetAlternativeSpeedLimits(context.Background(), 1, 0, -5)
+	require.ErrorIs(t, err, ErrInvalidSpeedLimits)
+}
@@ -0,0 +1,181 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"container/heap"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+)
+
+// Supported metrics for GetTopTorrents
+const (
+	TopMetricSize     = "size"
+	TopMetricUploaded = "uploaded"
+	TopMetricUpSpeed  = "upspeed"
+	TopMetricRatio    = "ratio"
+)
+
+// DefaultTopTorrentsLimit is the number of torrents returned by GetTopTorrents when no limit is given
+const DefaultTopTorrentsLimit = 10
+
+// topMetricValues extracts the value used for ranking torrents by metric
+var topMetricValues = map[string]func(t *qbt.Torrent) float64{
+	TopMetricSize:     func(t *qbt.Torrent) float64 { return float64(t.Size) },
+	TopMetricUploaded: func(t *qbt.Torrent) float64 { return float64(t.Uploaded) },
+	TopMetricUpSpeed:  func(t *qbt.Torrent) float64 { return float64(t.UpSpeed) },
+	TopMetricRatio:    func(t *qbt.Torrent) float64 { return t.Ratio },
+}
+
+// TopTorrentsResponse represents a leaderboard of torrents for a single metric
+type TopTorrentsResponse struct {
+	Metric   string        `json:"metric"`
+	Limit    int           `json:"limit"`
+	Torrents []qbt.Torrent `json:"torrents"`
+}
+
+// IsValidTopMetric reports whether metric is supported by GetTopTorrents
+func IsValidTopMetric(metric string) bool {
+	_, ok := topMetricValues[metric]
+	return ok
+}
+
+// GetTopTorrents returns the top-N torrents of an instance ranked by the given metric
+func (sm *SyncManager) GetTopTorrents(ctx context.Context, instanceID int, metric string, limit int) (*TopTorrentsResponse, error) {
+	valueOf, ok := topMetricValues[metric]
+	if !ok {
+		return nil, fmt.Errorf("unsupported metric: %s", metric)
+	}
+
+	if limit <= 0 {
+		limit = DefaultTopTorrentsLimit
+	}
+
+	torrents, err := sm.getAllTorrentsForStats(ctx, instanceID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return &TopTorrentsResponse{
+		Metric:   metric,
+		Limit:    limit,
+		Torrents: selectTopTorrents(torrents, valueOf, limit),
+	}, nil
+}
+
+// selectTopTorrents partially sorts torrents, keeping only the highest limit values in descending order
+func selectTopTorrents(torrents []qbt.Torrent, valueOf func(t *qbt.Torrent) float64, limit int) []qbt.Torrent {
+	h := &torrentMinHeap{valueOf: valueOf}
+	for i := range torrents {
+		if h.Len() < limit {
+			heap.Push(h, &torrents[i])
+			continue
+		}
+		if valueOf(&torrents[i]) > valueOf(h.items[0]) {
+			h.items[0] = &torrents[i]
+			heap.Fix(h, 0)
+		}
+	}
+
+	top := make([]qbt.Torrent, 0, h.Len())
+	for _, torrent := range h.items {
+		top = append(top, *torrent)
+	}
+
+	// Order by value, falling back to name so equal values don't jitter between refreshes
+	slices.SortStableFunc(top, func(a, b qbt.Torrent) int {
+		va, vb := valueOf(&a), valueOf(&b)
+		switch {
+		case va > vb:
+			return -1
+		case va < vb:
+			return 1
+		}
+		if a.Name < b.Name {
+			return -1
+		}
+		if a.Name > b.Name {
+			return 1
+		}
+		return 0
+	})
+
+	return top
+}
+
+// DefaultRecentlyCompletedLimit is the number of torrents returned by GetRecentlyCompleted when no limit is given
+const DefaultRecentlyCompletedLimit = 10
+
+// RecentlyCompletedTorrent is a finished download in the recently completed feed
+type RecentlyCompletedTorrent struct {
+	Hash        string    `json:"hash"`
+	Name        string    `json:"name"`
+	Size        int64     `json:"size"`
+	Tracker     string    `json:"tracker"` // Domain of the current tracker
+	Ratio       float64   `json:"ratio"`
+	CompletedAt time.Time `json:"completedAt"`
+}
+
+// GetRecentlyCompleted returns the torrents of an instance that finished downloading most
+// recently, newest first. Torrents without a completion time are left out.
+func (sm *SyncManager) GetRecentlyCompleted(ctx context.Context, instanceID int, limit int) ([]RecentlyCompletedTorrent, error) {
+	if limit <= 0 {
+		limit = DefaultRecentlyCompletedLimit
+	}
+
+	torrents, err := sm.getAllTorrentsForStats(ctx, instanceID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return sm.recentlyCompleted(torrents, limit), nil
+}
+
+func (sm *SyncManager) recentlyCompleted(torrents []qbt.Torrent, limit int) []RecentlyCompletedTorrent {
+	completed := make([]qbt.Torrent, 0, len(torrents))
+	for _, torrent := range torrents {
+		if torrent.CompletionOn > 0 {
+			completed = append(completed, torrent)
+		}
+	}
+
+	latest := selectTopTorrents(completed, func(t *qbt.Torrent) float64 { return float64(t.CompletionOn) }, limit)
+
+	result := make([]RecentlyCompletedTorrent, len(latest))
+	for i, torrent := range latest {
+		result[i] = RecentlyCompletedTorrent{
+			Hash:        torrent.Hash,
+			Name:        torrent.Name,
+			Size:        torrent.Size,
+			Tracker:     sm.extractDomainFromURL(torrent.Tracker),
+			Ratio:       torrent.Ratio,
+			CompletedAt: time.Unix(torrent.CompletionOn, 0).UTC(),
+		}
+	}
+	return result
+}
+
+// torrentMinHeap is a min-heap of torrents keyed by a metric value
+type torrentMinHeap struct {
+	items   []*qbt.Torrent
+	valueOf func(t *qbt.Torrent) float64
+}
+
+func (h *torrentMinHeap) Len() int { return len(h.items) }
+func (h *torrentMinHeap) Less(i, j int) bool {
+	return h.valueOf(h.items[i]) < h.valueOf(h.items[j])
+}
+func (h *torrentMinHeap) Swap(i, j int) { h.items[i], h.items[j] = h.items[j], h.items[i] }
+func (h *torrentMinHeap) Push(x any)    { h.items = append(h.items, x.(*qbt.Torrent)) }
+func (h *torrentMinHeap) Pop() any {
+	old := h.items
+	n := len(old)
+	item := old[n-1]
+	h.items = old[:n-1]
+	return item
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"testing"
+	"time"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelectTopTorrents(t *testing.T) {
+	torrents := createTestTorrents(50)
+
+	top := selectTopTorrents(torrents, topMetricValues[TopMetricSize], 5)
+
+	assert.Len(t, top, 5)
+	assert.Equal(t, "hash49", top[0].Hash, "Largest torrent should be first")
+	for i := 1; i < len(top); i++ {
+		assert.GreaterOrEqual(t, top[i-1].Size, top[i].Size, "Results should be in descending order")
+	}
+
+	t.Run("limit larger than input", func(t *testing.T) {
+		top := selectTopTorrents(torrents[:3], topMetricValues[TopMetricRatio], 10)
+		assert.Len(t, top, 3)
+	})
+
+	t.Run("ties ordered by name", func(t *testing.T) {
+		tied := []qbt.Torrent{{Name: "b", Ratio: 1}, {Name: "a", Ratio: 1}, {Name: "c", Ratio: 0.5}}
+		top := selectTopTorrents(tied, topMetricValues[TopMetricRatio], 2)
+		assert.Equal(t, []string{"a", "b"}, []string{top[0].Name, top[1].Name})
+	})
+}
+
+func TestRecentlyCompleted(t *testing.T) {
+	sm := &SyncManager{}
+	torrents := []qbt.Torrent{
+		{Hash: "old", Name: "old", CompletionOn: 1_700_000_000, Tracker: "https://tracker.example.org/announce", Ratio: 2},
+		{Hash: "downloading", Name: "downloading", CompletionOn: -1},
+		{Hash: "new", Name: "new", CompletionOn: 1_700_000_500, Size: 1024},
+		{Hash: "unknown", Name: "unknown"},
+		{Hash: "middle", Name: "middle", CompletionOn: 1_700_000_100},
+	}
+
+	recent := sm.recentlyCompleted(torrents, 2)
+
+	assert.Len(t, recent, 2)
+	assert.Equal(t, "new", recent[0].Hash)
+	assert.Equal(t, int64(1024), recent[0].Size)
+	assert.Equal(t, time.Unix(1_700_000_500, 0).UTC(), recent[0].CompletedAt)
+	assert.Equal(t, "middle", recent[1].Hash)
+
+	all := sm.recentlyCompleted(torrents, 10)
+	assert.Len(t, all, 3, "torrents without a completion time are left out")
+	assert.Equal(t, "tracker.example.org", all[2].Tracker)
+}
+
+func TestCalculateTimeBuckets(t *testing.T) {
+	loc := time.FixedZone("test", 2*60*60)
+	// Wednesday 2025-01-15 12:00 local
+	now := time.Date(2025, time.January, 15, 12, 0, 0, 0, loc)
+
+	at := func(year int, month time.Month, day, hour int) int64 {
+		return time.Date(year, month, day, hour, 0, 0, 0, loc).Unix()
+	}
+
+	torrents := []qbt.Torrent{
+		{AddedOn: at(2025, time.January, 15, 1), CompletionOn: at(2025, time.January, 15, 2)}, // today
+		{AddedOn: at(2025, time.January, 13, 0), CompletionOn: at(2025, time.January, 14, 9)}, // Monday, this week
+		{AddedOn: at(2025, time.January, 12, 23), CompletionOn: -1},                           // Sunday, last week
+		{AddedOn: at(2024, time.December, 31, 12)},                                            // last month
+	}
+
+	buckets := calculateTimeBuckets(torrents, now)
+
+	assert.Equal(t, 1, buckets.AddedToday)
+	assert.Equal(t, 2, buckets.AddedThisWeek)
+	assert.Equal(t, 3, buckets.AddedThisMonth)
+	assert.Equal(t, 1, buckets.CompletedToday)
+	assert.Equal(t, 2, buckets.CompletedThisWeek)
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"context"
+	"time"
+)
+
+// syncStaleIntervals is how many sync intervals may pass without a sync before it is reported
+// as stale. Syncs run when data is requested, so a few missed intervals are normal.
+const syncStaleIntervals = 3
+
+// SyncHealth describes how current an instance's synced data is. Unlike the connection health
+// it shows syncs falling behind or failing while the instance is still reachable.
+type SyncHealth struct {
+	InstanceID         int        `json:"instanceId"`
+	LastSyncTime       *time.Time `json:"lastSyncTime,omitempty"`
+	SinceLastSyncMs    int64      `json:"sinceLastSyncMs"`
+	LastSyncDurationMs int64      `json:"lastSyncDurationMs"`
+	SyncIntervalMs     int64      `json:"syncIntervalMs"`
+	Stale              bool       `json:"stale"`
+	Ready              bool       `json:"ready"` // First sync completed; torrent operations wait for it
+	LastSyncError      string     `json:"lastSyncError,omitempty"`
+	// OptimisticBacklog is the number of optimistic updates still waiting for a sync to confirm them
+	OptimisticBacklog int `json:"optimisticBacklog"`
+}
+
+// GetSyncHealth reports the sync state of an instance from the sync manager's cached state,
+// without triggering a sync
+func (sm *SyncManager) GetSyncHealth(ctx context.Context, instanceID int) (*SyncHealth, error) {
+	client, syncManager, err := sm.getClientAndSyncManagerAllowWarmup(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	health := newSyncHealth(instanceID, syncManager.LastSyncTime(), time.Now(), client.SyncInterval())
+	health.LastSyncDurationMs = syncManager.LastSyncDuration().Milliseconds()
+	health.LastSyncError = client.getSyncError()
+	health.Ready = client.IsSynced()
+	health.OptimisticBacklog = len(client.getOptimisticUpdates())
+
+	return health, nil
+}
+
+// newSyncHealth fills in the timing of a sync health report. An instance that never synced is stale.
+func newSyncHealth(instanceID int, lastSync, now time.Time, interval time.Duration) *SyncHealth {
+	health := &SyncHealth{
+		InstanceID:     instanceID,
+		SyncIntervalMs: interval.Milliseconds(),
+		Stale:          true,
+	}
+	if lastSync.IsZero() {
+		return health
+	}
+
+	elapsed := now.Sub(lastSync)
+	health.LastSyncTime = &lastSync
+	health.SinceLastSyncMs = elapsed.Milliseconds()
+	health.Stale = elapsed > syncStaleIntervals*interval
+
+	return health
+}
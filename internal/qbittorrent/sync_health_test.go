@@ -0,0 +1,74 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/autobrr/autobrr/pkg/ttlcache"
+	qbt "github.com/autobrr/go-qbittorrent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSyncHealth(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	interval := 2 * time.Second
+
+	never := newSyncHealth(1, time.Time{}, now, interval)
+	assert.Nil(t, never.LastSyncTime)
+	assert.True(t, never.Stale, "an instance that never synced is stale")
+	assert.Equal(t, int64(2000), never.SyncIntervalMs)
+
+	recent := newSyncHealth(1, now.Add(-5*time.Second), now, interval)
+	require.NotNil(t, recent.LastSyncTime)
+	assert.Equal(t, int64(5000), recent.SinceLastSyncMs)
+	assert.False(t, recent.Stale, "a few missed intervals are not stale")
+
+	behind := newSyncHealth(1, now.Add(-7*time.Second), now, interval)
+	assert.True(t, behind.Stale)
+}
+
+func TestInstanceReadyWaitsForFirstSync(t *testing.T) {
+	var up atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !up.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"rid":1,"full_update":true,"torrents":{}}`))
+	}))
+	defer server.Close()
+
+	client := &Client{
+		isHealthy:         true,
+		syncManager:       qbt.NewClient(qbt.Config{Host: server.URL}).NewSyncManager(qbt.DefaultSyncOptions()),
+		optimisticUpdates: ttlcache.New(ttlcache.Options[string, *OptimisticTorrentUpdate]{}),
+	}
+	sm := &SyncManager{clientPool: &ClientPool{clients: map[int]*Client{1: client}}}
+	ctx := context.Background()
+
+	require.ErrorIs(t, sm.InstanceReady(ctx, 1), ErrInstanceWarmingUp)
+
+	// Sync health stays available so a stuck first sync can be diagnosed
+	health, err := sm.GetSyncHealth(ctx, 1)
+	require.NoError(t, err)
+	assert.False(t, health.Ready)
+
+	// Warm-up syncs are spaced out, so an instance coming back isn't retried on every request
+	up.Store(true)
+	require.ErrorIs(t, sm.InstanceReady(ctx, 1), ErrInstanceWarmingUp)
+
+	client.lastWarmUp = time.Time{}
+	require.NoError(t, sm.InstanceReady(ctx, 1), "A failed startup sync should recover without a manual resync")
+
+	health, err = sm.GetSyncHealth(ctx, 1)
+	require.NoError(t, err)
+	assert.True(t, health.Ready)
+}
@@ -5,12 +5,16 @@ package qbittorrent
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"maps"
 	"net/url"
 	"path/filepath"
 	"slices"
 	"sort"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/autobrr/autobrr/pkg/ttlcache"
@@ -30,20 +34,59 @@ type CacheMetadata struct {
 	Age         int    `json:"age"`         // Age in seconds
 	IsStale     bool   `json:"isStale"`     // Whether data is stale
 	NextRefresh string `json:"nextRefresh"` // When next refresh will occur (ISO 8601 string)
+	// SyncIntervalMs is the instance's sync cadence, so clients can poll in step with it
+	SyncIntervalMs int64 `json:"syncIntervalMs"`
+}
+
+// newCacheMetadata describes data last synced at lastSync. Data is fresh within one sync
+// interval; stale data is refreshed by the next request.
+func newCacheMetadata(lastSync, now time.Time, interval time.Duration) *CacheMetadata {
+	elapsed := now.Sub(lastSync)
+	isFresh := elapsed <= interval
+
+	source := "cache"
+	nextRefresh := now
+	if isFresh {
+		source = "fresh"
+		nextRefresh = lastSync.Add(interval)
+	}
+
+	return &CacheMetadata{
+		Source:         source,
+		Age:            int(elapsed.Seconds()),
+		IsStale:        !isFresh,
+		NextRefresh:    nextRefresh.Format(time.RFC3339),
+		SyncIntervalMs: interval.Milliseconds(),
+	}
+}
+
+// DefaultSyncInterval is the sync interval of instances whose syncs are not slowed down
+func DefaultSyncInterval() time.Duration {
+	return qbt.DefaultSyncOptions().SyncInterval
+}
+
+// GetSyncIntervals returns the current sync interval of each connected instance
+func (sm *SyncManager) GetSyncIntervals() map[int]time.Duration {
+	intervals := make(map[int]time.Duration)
+	for _, client := range sm.clientPool.connectedClients() {
+		intervals[client.GetInstanceID()] = client.SyncInterval()
+	}
+	return intervals
 }
 
 // TorrentResponse represents a response containing torrents with stats
 type TorrentResponse struct {
-	Torrents      []qbt.Torrent           `json:"torrents"`
-	Total         int                     `json:"total"`
-	Stats         *TorrentStats           `json:"stats,omitempty"`
-	Counts        *TorrentCounts          `json:"counts,omitempty"`      // Include counts for sidebar
-	Categories    map[string]qbt.Category `json:"categories,omitempty"`  // Include categories for sidebar
-	Tags          []string                `json:"tags,omitempty"`        // Include tags for sidebar
-	ServerState   *qbt.ServerState        `json:"serverState,omitempty"` // Include server state for Dashboard
-	HasMore       bool                    `json:"hasMore"`               // Whether more pages are available
-	SessionID     string                  `json:"sessionId,omitempty"`   // Optional session tracking
-	CacheMetadata *CacheMetadata          `json:"cacheMetadata,omitempty"`
+	Torrents      []qbt.Torrent              `json:"torrents"`
+	Total         int                        `json:"total"`
+	Stats         *TorrentStats              `json:"stats,omitempty"`
+	Counts        *TorrentCounts             `json:"counts,omitempty"`      // Include counts for sidebar
+	Categories    map[string]SidebarCategory `json:"categories,omitempty"`  // Include categories for sidebar
+	Tags          []string                   `json:"tags,omitempty"`        // Include tags for sidebar
+	ServerState   *qbt.ServerState           `json:"serverState,omitempty"` // Include server state for Dashboard
+	HasMore       bool                       `json:"hasMore"`               // Whether more pages are available
+	Limit         int                        `json:"limit"`                 // Page size actually applied
+	SessionID     string                     `json:"sessionId,omitempty"`   // Optional session tracking
+	CacheMetadata *CacheMetadata             `json:"cacheMetadata,omitempty"`
 }
 
 // TorrentStats represents aggregated torrent statistics
@@ -54,13 +97,50 @@ type TorrentStats struct {
 	Paused             int `json:"paused"`
 	Error              int `json:"error"`
 	Checking           int `json:"checking"`
+	Active             int `json:"active"` // Under the configured active definition
 	TotalDownloadSpeed int `json:"totalDownloadSpeed"`
 	TotalUploadSpeed   int `json:"totalUploadSpeed"`
 }
 
 // SyncManager manages torrent operations
 type SyncManager struct {
-	clientPool *ClientPool
+	clientPool              *ClientPool
+	optimisticUpdateTimeout atomic.Int64 // nanoseconds
+	searchJobs              *ttlcache.Cache[searchJobKey, *SearchJob]
+	searchWeights           atomic.Pointer[models.SearchWeights]
+	duplicateStripPatterns  sync.Map // instance ID -> []*regexp.Regexp
+	reannounces             *reannounceGuard
+	torrentChanges          *torrentChangeTracker
+	recheckQueues           sync.Map // instance ID -> *recheckQueue
+	jobs                    sync.Map // job ID -> *job
+	timeZone                atomic.Pointer[time.Location]
+	defaultPageSize         atomic.Int64
+	maxPageSize             atomic.Int64
+	activeWindow            atomic.Int64 // nanoseconds, 0 for the state-based definition
+	sidebarMetadata         *models.SidebarMetadataStore
+	sidebarCache            map[int]*models.SidebarMetadata // Sidebar metadata by instance, dropped on every change
+	sidebarCacheGen         uint64                          // Bumped on every change so reads that raced it aren't cached
+	sidebarCacheMu          sync.RWMutex
+}
+
+// DefaultOptimisticUpdateTimeout is the safety net after which optimistic updates are always cleared
+const DefaultOptimisticUpdateTimeout = 60 * time.Second
+
+// optimisticSyncGraceDivisor derives the sync grace from the optimistic update timeout, so the
+// default 60s timeout trusts the backend once it has synced 10s past an update
+const optimisticSyncGraceDivisor = 6
+
+// optimisticSyncGrace is how far the last sync must be past an optimistic update before the
+// backend state is trusted over the optimistic one, even if the expected state never appeared.
+// It scales with the timeout so raising the timeout also keeps updates through more syncs.
+func optimisticSyncGrace(timeout time.Duration) time.Duration {
+	return timeout / optimisticSyncGraceDivisor
+}
+
+// syncedPastOptimisticUpdate reports whether the backend has synced far enough past an optimistic
+// update for its state to be authoritative
+func syncedPastOptimisticUpdate(updatedAt, lastSyncTime time.Time, timeout time.Duration) bool {
+	return lastSyncTime.Sub(updatedAt) > optimisticSyncGrace(timeout)
 }
 
 // OptimisticTorrentUpdate represents a temporary optimistic update to a torrent
@@ -73,9 +153,44 @@ type OptimisticTorrentUpdate struct {
 
 // NewSyncManager creates a new sync manager
 func NewSyncManager(clientPool *ClientPool) *SyncManager {
-	return &SyncManager{
-		clientPool: clientPool,
+	sm := &SyncManager{
+		clientPool:     clientPool,
+		reannounces:    newReannounceGuard(),
+		torrentChanges: newTorrentChangeTracker(),
+	}
+	sm.optimisticUpdateTimeout.Store(int64(DefaultOptimisticUpdateTimeout))
+	sm.searchJobs = sm.newSearchJobCache()
+	return sm
+}
+
+// SetOptimisticUpdateTimeout sets how long optimistic updates are kept before being discarded.
+// Non-positive values restore the default.
+func (sm *SyncManager) SetOptimisticUpdateTimeout(timeout time.Duration) {
+	if timeout <= 0 {
+		timeout = DefaultOptimisticUpdateTimeout
+	}
+	sm.optimisticUpdateTimeout.Store(int64(timeout))
+}
+
+// getOptimisticUpdateTimeout returns the configured optimistic update timeout
+func (sm *SyncManager) getOptimisticUpdateTimeout() time.Duration {
+	if timeout := time.Duration(sm.optimisticUpdateTimeout.Load()); timeout > 0 {
+		return timeout
+	}
+	return DefaultOptimisticUpdateTimeout
+}
+
+// SetSearchWeights sets the field weights used to rank search matches
+func (sm *SyncManager) SetSearchWeights(weights models.SearchWeights) {
+	sm.searchWeights.Store(&weights)
+}
+
+// GetSearchWeights returns the field weights used to rank search matches
+func (sm *SyncManager) GetSearchWeights() models.SearchWeights {
+	if weights := sm.searchWeights.Load(); weights != nil {
+		return *weights
 	}
+	return models.DefaultSearchWeights()
 }
 
 // GetErrorStore returns the error store for recording errors
@@ -83,8 +198,29 @@ func (sm *SyncManager) GetErrorStore() *models.InstanceErrorStore {
 	return sm.clientPool.GetErrorStore()
 }
 
-// getClientAndSyncManager gets both client and sync manager with error handling
+// ErrInstanceWarmingUp is returned for an instance whose first sync hasn't completed yet
+var ErrInstanceWarmingUp = errors.New("instance is still loading its torrents")
+
+// getClientAndSyncManager gets both client and sync manager with error handling. Until the first
+// sync has completed it tries a bounded sync and fails with ErrInstanceWarmingUp if that doesn't
+// complete it.
 func (sm *SyncManager) getClientAndSyncManager(ctx context.Context, instanceID int) (*Client, *qbt.SyncManager, error) {
+	client, syncManager, err := sm.getClientAndSyncManagerAllowWarmup(ctx, instanceID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// An empty cache before the first sync would look like an instance without torrents
+	if !client.warmUp(ctx) {
+		return nil, nil, ErrInstanceWarmingUp
+	}
+
+	return client, syncManager, nil
+}
+
+// getClientAndSyncManagerAllowWarmup is getClientAndSyncManager for callers that must work before
+// the first sync, such as forcing a resync or reporting sync health
+func (sm *SyncManager) getClientAndSyncManagerAllowWarmup(ctx context.Context, instanceID int) (*Client, *qbt.SyncManager, error) {
 	// Get client
 	client, err := sm.clientPool.GetClient(ctx, instanceID)
 	if err != nil {
@@ -100,6 +236,13 @@ func (sm *SyncManager) getClientAndSyncManager(ctx context.Context, instanceID i
 	return client, syncManager, nil
 }
 
+// InstanceReady returns ErrInstanceWarmingUp until the first sync of the instance has completed,
+// or the error getting its client
+func (sm *SyncManager) InstanceReady(ctx context.Context, instanceID int) error {
+	_, _, err := sm.getClientAndSyncManager(ctx, instanceID)
+	return err
+}
+
 // validateTorrentsExist checks if the specified torrent hashes exist
 func (sm *SyncManager) validateTorrentsExist(client *Client, hashes []string, operation string) error {
 	existingTorrents := client.getTorrentsByHashes(hashes)
@@ -110,8 +253,17 @@ func (sm *SyncManager) validateTorrentsExist(client *Client, hashes []string, op
 }
 
 // GetTorrentsWithFilters gets torrents with filters, search, sorting, and pagination
-// Always fetches fresh data from sync manager for real-time updates
-func (sm *SyncManager) GetTorrentsWithFilters(ctx context.Context, instanceID int, limit, offset int, sort, order, search string, filters FilterOptions) (*TorrentResponse, error) {
+// Always fetches fresh data from sync manager for real-time updates. With naturalSort, sorting
+// by name compares runs of digits as numbers, so "Episode 2" sorts before "Episode 10".
+// Limits outside the configured page size bounds are clamped and a negative offset starts at
+// the first torrent; the response reports the limit applied.
+func (sm *SyncManager) GetTorrentsWithFilters(ctx context.Context, instanceID int, limit, offset int, sort, order string, naturalSort bool, search string, filters FilterOptions) (*TorrentResponse, error) {
+	return sm.getTorrentsWithFilters(ctx, instanceID, sm.PageSize(limit), max(offset, 0), sort, order, naturalSort, search, filters)
+}
+
+// getTorrentsWithFilters is GetTorrentsWithFilters without page size bounds, for internal
+// callers that resolve a whole selection
+func (sm *SyncManager) getTorrentsWithFilters(ctx context.Context, instanceID int, limit, offset int, sort, order string, naturalSort bool, search string, filters FilterOptions) (*TorrentResponse, error) {
 	// Always get fresh data from sync manager for real-time updates
 	var filteredTorrents []qbt.Torrent
 	var err error
@@ -193,16 +345,16 @@ func (sm *SyncManager) GetTorrentsWithFilters(ctx context.Context, instanceID in
 
 		// Handle single status filter
 		if len(filters.Status) == 1 {
-			status := filters.Status[0]
+			status := CanonicalStatus(filters.Status[0])
 			switch status {
 			case "all":
 				torrentFilterOptions.Filter = qbt.TorrentFilterAll
 			case "completed":
 				torrentFilterOptions.Filter = qbt.TorrentFilterCompleted
-			case "running", "resumed":
+			case StatusRunning:
 				// Use TorrentFilterRunning - go-qbittorrent will translate based on version
 				torrentFilterOptions.Filter = qbt.TorrentFilterRunning
-			case "paused", "stopped":
+			case StatusStopped:
 				// Use TorrentFilterStopped - go-qbittorrent will translate based on version
 				torrentFilterOptions.Filter = qbt.TorrentFilterStopped
 			case "stalled":
@@ -252,7 +404,7 @@ func (sm *SyncManager) GetTorrentsWithFilters(ctx context.Context, instanceID in
 
 	// Apply search filter if provided (library doesn't support search)
 	if search != "" {
-		filteredTorrents = sm.filterTorrentsBySearch(filteredTorrents, search)
+		filteredTorrents = sm.filterTorrentsBySearch(filteredTorrents, search, client.fuzzySearch)
 	}
 
 	log.Debug().
@@ -260,12 +412,19 @@ func (sm *SyncManager) GetTorrentsWithFilters(ctx context.Context, instanceID in
 		Int("filtered", len(filteredTorrents)).
 		Msg("Applied search filtering")
 
+	sm.applyQueueOverlay(client, syncManager.LastSyncTime(), filteredTorrents)
+
 	// Apply custom sorting for priority field
 	// qBittorrent's native sorting treats 0 as lowest, but we want it as highest (no priority)
 	if sort == "priority" {
 		sm.sortTorrentsByPriority(filteredTorrents, order == "desc")
 	}
 
+	// The library compares names byte by byte; natural order needs a sort of its own
+	if sort == "name" && naturalSort {
+		sortTorrentsByNaturalName(filteredTorrents, order == "desc")
+	}
+
 	// Calculate stats from filtered torrents
 	stats := sm.calculateStats(filteredTorrents)
 
@@ -304,6 +463,10 @@ func (sm *SyncManager) GetTorrentsWithFilters(ctx context.Context, instanceID in
 		tags = []string{}
 	}
 
+	sidebarMetadata := sm.loadSidebarMetadata(ctx, instanceID)
+	sidebarCategories := mergeSidebarCategories(categories, sidebarMetadata)
+	attachSidebarMetadata(counts, sidebarMetadata)
+
 	// Determine cache metadata based on last sync update time
 	var cacheMetadata *CacheMetadata
 	var serverState *qbt.ServerState
@@ -311,22 +474,7 @@ func (sm *SyncManager) GetTorrentsWithFilters(ctx context.Context, instanceID in
 	if clientErr == nil {
 		syncManager := client.GetSyncManager()
 		if syncManager != nil {
-			lastSyncTime := syncManager.LastSyncTime()
-			now := time.Now()
-			age := int(now.Sub(lastSyncTime).Seconds())
-			isFresh := age <= 1 // Fresh if updated within the last second
-
-			source := "cache"
-			if isFresh {
-				source = "fresh"
-			}
-
-			cacheMetadata = &CacheMetadata{
-				Source:      source,
-				Age:         age,
-				IsStale:     !isFresh,
-				NextRefresh: now.Add(time.Second).Format(time.RFC3339),
-			}
+			cacheMetadata = newCacheMetadata(syncManager.LastSyncTime(), time.Now(), client.SyncInterval())
 
 			// Get server state from sync manager for Dashboard
 			serverStateData := syncManager.GetServerState()
@@ -338,11 +486,12 @@ func (sm *SyncManager) GetTorrentsWithFilters(ctx context.Context, instanceID in
 		Torrents:      paginatedTorrents,
 		Total:         len(filteredTorrents),
 		Stats:         stats,
-		Counts:        counts,      // Include counts for sidebar
-		Categories:    categories,  // Include categories for sidebar
-		Tags:          tags,        // Include tags for sidebar
-		ServerState:   serverState, // Include server state for Dashboard
+		Counts:        counts,            // Include counts for sidebar
+		Categories:    sidebarCategories, // Include categories for sidebar
+		Tags:          tags,              // Include tags for sidebar
+		ServerState:   serverState,       // Include server state for Dashboard
 		HasMore:       hasMore,
+		Limit:         limit,
 		CacheMetadata: cacheMetadata,
 	}
 
@@ -408,7 +557,11 @@ func (sm *SyncManager) BulkAction(ctx context.Context, instanceID int, hashes []
 	case "pause":
 		err = client.PauseCtx(ctx, hashes)
 	case "resume":
+		// Resumed torrents still obey the queue limits and may stay queued
 		err = client.ResumeCtx(ctx, hashes)
+	case "resumeForce":
+		// Force start bypasses the queue so the torrents run regardless of active torrent limits
+		err = client.SetForceStartCtx(ctx, hashes, true)
 	case "delete":
 		err = client.DeleteTorrentsCtx(ctx, hashes, false)
 	case "deleteWithFiles":
@@ -417,7 +570,7 @@ func (sm *SyncManager) BulkAction(ctx context.Context, instanceID int, hashes []
 		err = client.RecheckCtx(ctx, hashes)
 	case "reannounce":
 		// No cache update needed - no visible state change
-		err = client.ReAnnounceTorrentsCtx(ctx, hashes)
+		_, err = sm.reannounce(ctx, client, instanceID, hashes)
 	case "increasePriority":
 		err = client.IncreasePriorityCtx(ctx, hashes)
 		if err == nil {
@@ -453,6 +606,8 @@ func (sm *SyncManager) AddTorrent(ctx context.Context, instanceID int, fileConte
 		return err
 	}
 
+	options = sm.applyAddDefaults(ctx, instanceID, options)
+
 	// Use AddTorrentFromMemoryCtx which accepts byte array
 	if err := client.AddTorrentFromMemoryCtx(ctx, fileContent, options); err != nil {
 		return err
@@ -464,7 +619,29 @@ func (sm *SyncManager) AddTorrent(ctx context.Context, instanceID int, fileConte
 	return nil
 }
 
-// AddTorrentFromURLs adds new torrents from URLs or magnet links
+// applyAddDefaults merges the instance's add defaults into options without overriding caller values
+func (sm *SyncManager) applyAddDefaults(ctx context.Context, instanceID int, options map[string]string) map[string]string {
+	instance, err := sm.clientPool.instanceStore.Get(ctx, instanceID)
+	if err != nil {
+		log.Warn().Err(err).Int("instanceID", instanceID).Msg("Failed to load instance add defaults")
+		return options
+	}
+
+	// Copy so callers reusing the map for several adds don't see the defaults
+	merged := make(map[string]string, len(options))
+	maps.Copy(merged, options)
+
+	return instance.AddDefaults.Apply(merged)
+}
+
+// HasPausedAddDefault reports whether the instance defines a default paused state for new torrents
+func (sm *SyncManager) HasPausedAddDefault(ctx context.Context, instanceID int) bool {
+	instance, err := sm.clientPool.instanceStore.Get(ctx, instanceID)
+	return err == nil && instance.AddDefaults.Paused != nil
+}
+
+// AddTorrentFromURLs adds new torrents from URLs or magnet links. A "cookie" option is sent
+// when qBittorrent downloads the .torrent files, for sites that require a login session.
 func (sm *SyncManager) AddTorrentFromURLs(ctx context.Context, instanceID int, urls []string, options map[string]string) error {
 	// Get client and sync manager
 	client, _, err := sm.getClientAndSyncManager(ctx, instanceID)
@@ -472,6 +649,16 @@ func (sm *SyncManager) AddTorrentFromURLs(ctx context.Context, instanceID int, u
 		return err
 	}
 
+	options = sm.applyAddDefaults(ctx, instanceID, options)
+
+	if cookie, ok := options["cookie"]; ok {
+		normalized, err := normalizeTorrentCookie(cookie)
+		if err != nil {
+			return err
+		}
+		options["cookie"] = normalized
+	}
+
 	// Add each URL/magnet link
 	for _, url := range urls {
 		url = strings.TrimSpace(url)
@@ -522,8 +709,8 @@ func (sm *SyncManager) GetTags(ctx context.Context, instanceID int) ([]string, e
 	return tags, nil
 }
 
-// GetTorrentProperties gets detailed properties for a specific torrent
-func (sm *SyncManager) GetTorrentProperties(ctx context.Context, instanceID int, hash string) (*qbt.TorrentProperties, error) {
+// GetTorrentProperties gets detailed properties for a specific torrent, including its origin
+func (sm *SyncManager) GetTorrentProperties(ctx context.Context, instanceID int, hash string) (*TorrentDetails, error) {
 	// Get client and sync manager
 	client, _, err := sm.getClientAndSyncManager(ctx, instanceID)
 	if err != nil {
@@ -536,7 +723,10 @@ func (sm *SyncManager) GetTorrentProperties(ctx context.Context, instanceID int,
 		return nil, fmt.Errorf("failed to get torrent properties: %w", err)
 	}
 
-	return &props, nil
+	// Keep the private flag cache warm for the private torrent guards
+	client.privateFlags.Set(hash, props.IsPrivate, privateFlagTTL)
+
+	return newTorrentDetails(props), nil
 }
 
 // GetTorrentTrackers gets trackers for a specific torrent
@@ -595,11 +785,29 @@ func (sm *SyncManager) GetTorrentFiles(ctx context.Context, instanceID int, hash
 
 // TorrentCounts represents counts for filtering sidebar
 type TorrentCounts struct {
-	Status     map[string]int `json:"status"`
+	Status     map[string]int `json:"status"` // Includes the legacy paused and resumed names
 	Categories map[string]int `json:"categories"`
 	Tags       map[string]int `json:"tags"`
 	Trackers   map[string]int `json:"trackers"`
 	Total      int            `json:"total"`
+	// StatusCanonical holds the status counts under their canonical names only
+	StatusCanonical map[string]int `json:"statusCanonical"`
+	// Terms is the wording the instance's version uses for the run states
+	Terms StatusTerms `json:"terms"`
+	// TimeBuckets is only populated when explicitly requested to keep the default payload lean
+	TimeBuckets *TimeBucketCounts `json:"timeBuckets,omitempty"`
+	// CategoryMetadata and TagMetadata hold the colors and order of the counted categories and tags
+	CategoryMetadata map[string]models.SidebarItemMetadata `json:"categoryMetadata,omitempty"`
+	TagMetadata      map[string]models.SidebarItemMetadata `json:"tagMetadata,omitempty"`
+}
+
+// TimeBucketCounts represents counts of torrents added/completed within recent time ranges
+type TimeBucketCounts struct {
+	AddedToday        int `json:"addedToday"`
+	AddedThisWeek     int `json:"addedThisWeek"`
+	AddedThisMonth    int `json:"addedThisMonth"`
+	CompletedToday    int `json:"completedToday"`
+	CompletedThisWeek int `json:"completedThisWeek"`
 }
 
 // InstanceSpeeds represents download/upload speeds for an instance
@@ -658,6 +866,45 @@ func (sm *SyncManager) recordTrackerTransition(client *Client, oldURL, newURL st
 	client.addTrackerExclusions(oldDomain, hashes)
 }
 
+// GetTrackerExclusions returns the hashes temporarily hidden from each tracker domain's count
+// after a tracker edit, until a fresh sync confirms the change. Hashes are sorted per domain.
+func (sm *SyncManager) GetTrackerExclusions(ctx context.Context, instanceID int) (map[string][]string, error) {
+	client, err := sm.clientPool.GetClient(ctx, instanceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client: %w", err)
+	}
+
+	exclusions := make(map[string][]string)
+	for domain, hashes := range client.getTrackerExclusionsCopy() {
+		list := make([]string, 0, len(hashes))
+		for hash := range hashes {
+			list = append(list, hash)
+		}
+		slices.Sort(list)
+		exclusions[domain] = list
+	}
+
+	return exclusions, nil
+}
+
+// ClearTrackerExclusions drops tracker exclusions for the given domains, or all of them when
+// no domains are given
+func (sm *SyncManager) ClearTrackerExclusions(ctx context.Context, instanceID int, domains []string) error {
+	client, err := sm.clientPool.GetClient(ctx, instanceID)
+	if err != nil {
+		return fmt.Errorf("failed to get client: %w", err)
+	}
+
+	if len(domains) == 0 {
+		domains = slices.Collect(maps.Keys(client.getTrackerExclusionsCopy()))
+	}
+	client.clearTrackerExclusions(domains)
+
+	log.Debug().Int("instanceID", instanceID).Strs("domains", domains).Msg("Cleared tracker exclusions")
+
+	return nil
+}
+
 // countTorrentStatuses counts torrent statuses efficiently in a single pass
 func (sm *SyncManager) countTorrentStatuses(torrent qbt.Torrent, counts map[string]int) {
 	// Count "all"
@@ -668,28 +915,21 @@ func (sm *SyncManager) countTorrentStatuses(torrent qbt.Torrent, counts map[stri
 		counts["completed"]++
 	}
 
-	// Check active states for "active" and "inactive"
-	isActive := slices.Contains(torrentStateCategories[qbt.TorrentFilterActive], torrent.State)
-	if isActive {
+	// Count "active" and "inactive" under the configured definition
+	if sm.isActive(torrent) {
 		counts["active"]++
 	} else {
 		counts["inactive"]++
 	}
 
-	// Check stopped/paused states - both old PausedDl/Up and new StoppedDl/Up states
-	pausedStates := torrentStateCategories[qbt.TorrentFilterPaused]
-	stoppedStates := torrentStateCategories[qbt.TorrentFilterStopped]
-
-	// A torrent is considered stopped if it's in either paused or stopped states
-	isPausedOrStopped := slices.Contains(pausedStates, torrent.State) || slices.Contains(stoppedStates, torrent.State)
-
-	if isPausedOrStopped {
-		counts["stopped"]++
-		counts["paused"]++ // For backward compatibility
+	// Count the run state under its canonical name and the legacy name for older clients
+	if isStoppedState(torrent.State) {
+		counts[StatusStopped]++
+		counts["paused"]++
 	} else {
-		// Running is the inverse of stopped/paused
-		counts["running"]++
-		counts["resumed"]++ // For backward compatibility
+		// Running is the inverse of stopped
+		counts[StatusRunning]++
+		counts["resumed"]++
 	}
 
 	// Count other status categories
@@ -818,6 +1058,11 @@ func (sm *SyncManager) calculateCountsFromTorrentsWithTrackers(client *Client, a
 		}
 	}
 
+	counts.StatusCanonical = canonicalStatusCounts(counts.Status)
+	if client != nil {
+		counts.Terms = statusTerms(client.Capabilities())
+	}
+
 	return counts
 }
 
@@ -842,6 +1087,7 @@ func (sm *SyncManager) GetTorrentCounts(ctx context.Context, instanceID int) (*T
 
 	// Calculate counts using the shared function - pass mainData for tracker information
 	counts := sm.calculateCountsFromTorrentsWithTrackers(client, allTorrents, mainData)
+	attachSidebarMetadata(counts, sm.loadSidebarMetadata(ctx, instanceID))
 
 	// Don't cache counts separately - they're always derived from the cached torrent data
 	// This ensures sidebar and table are always in sync
@@ -858,6 +1104,58 @@ func (sm *SyncManager) GetTorrentCounts(ctx context.Context, instanceID int) (*T
 	return counts, nil
 }
 
+// GetTimeBucketCounts gets added/completed time range counts for the filter sidebar
+// Bucket boundaries use loc, or the configured time zone when loc is nil
+func (sm *SyncManager) GetTimeBucketCounts(ctx context.Context, instanceID int, loc *time.Location) (*TimeBucketCounts, error) {
+	allTorrents, err := sm.getAllTorrentsForStats(ctx, instanceID, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get all torrents for time buckets: %w", err)
+	}
+
+	return calculateTimeBuckets(allTorrents, time.Now().In(sm.location(loc))), nil
+}
+
+// calculateTimeBuckets counts torrents added/completed since the start of the day, week and month of now.
+// Weeks start on Monday and boundaries are computed in now's location.
+func calculateTimeBuckets(torrents []qbt.Torrent, now time.Time) *TimeBucketCounts {
+	loc := now.Location()
+	startOfDay := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+	daysSinceMonday := (int(startOfDay.Weekday()) + 6) % 7
+	startOfWeek := startOfDay.AddDate(0, 0, -daysSinceMonday)
+	startOfMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, loc)
+
+	dayUnix := startOfDay.Unix()
+	weekUnix := startOfWeek.Unix()
+	monthUnix := startOfMonth.Unix()
+
+	buckets := &TimeBucketCounts{}
+	for _, torrent := range torrents {
+		if torrent.AddedOn > 0 {
+			if torrent.AddedOn >= dayUnix {
+				buckets.AddedToday++
+			}
+			if torrent.AddedOn >= weekUnix {
+				buckets.AddedThisWeek++
+			}
+			if torrent.AddedOn >= monthUnix {
+				buckets.AddedThisMonth++
+			}
+		}
+
+		// CompletionOn is 0 (or negative on some versions) for incomplete torrents
+		if torrent.CompletionOn > 0 {
+			if torrent.CompletionOn >= dayUnix {
+				buckets.CompletedToday++
+			}
+			if torrent.CompletionOn >= weekUnix {
+				buckets.CompletedThisWeek++
+			}
+		}
+	}
+
+	return buckets
+}
+
 // GetInstanceSpeeds gets total download/upload speeds efficiently using GetTransferInfo
 // This is MUCH faster than fetching all torrents for large instances
 func (sm *SyncManager) GetInstanceSpeeds(ctx context.Context, instanceID int) (*InstanceSpeeds, error) {
@@ -900,6 +1198,27 @@ func (sm *SyncManager) applyOptimisticCacheUpdate(instanceID int, hashes []strin
 	client.applyOptimisticCacheUpdate(hashes, action, payload)
 }
 
+// ForceResync synchronously rebuilds the cache of an instance with a full sync from rid 0 and
+// discards the optimistic updates and tracker exclusions layered on top of it, so qBittorrent's
+// state is the single source of truth. It returns the time of the completed sync.
+func (sm *SyncManager) ForceResync(ctx context.Context, instanceID int) (time.Time, error) {
+	client, _, err := sm.getClientAndSyncManagerAllowWarmup(ctx, instanceID)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	client.resetTransientState()
+
+	syncManager, err := client.resetSyncManager(ctx)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to sync instance: %w", err)
+	}
+
+	log.Debug().Int("instanceID", instanceID).Msg("Forced instance resync")
+
+	return syncManager.LastSyncTime(), nil
+}
+
 // syncAfterModification performs a background sync after a modification operation
 func (sm *SyncManager) syncAfterModification(instanceID int, client *Client, operation string) {
 	go func() {
@@ -951,6 +1270,7 @@ func (sm *SyncManager) getAllTorrentsForStats(ctx context.Context, instanceID in
 		// Get the last sync time to detect if backend has responded since our optimistic update
 		// This provides much more accurate clearing than a fixed timeout
 		lastSyncTime := syncManager.LastSyncTime()
+		timeout := sm.getOptimisticUpdateTimeout()
 
 		optimisticCount := 0
 		removedCount := 0
@@ -973,13 +1293,23 @@ func (sm *SyncManager) getAllTorrentsForStats(ctx context.Context, instanceID in
 						Time("optimisticAt", optimisticUpdate.UpdatedAt).
 						Dur("timeSinceUpdate", timeSinceUpdate).
 						Msg("Clearing optimistic update - backend state indicates operation success")
-				} else if timeSinceUpdate > 60*time.Second {
-					// Safety net: still clear after 60 seconds if something went wrong
+				} else if syncedPastOptimisticUpdate(optimisticUpdate.UpdatedAt, lastSyncTime, timeout) {
+					// Backend has synced well past the update, so its state is authoritative
+					shouldClear = true
+					log.Debug().
+						Str("hash", hash).
+						Time("optimisticAt", optimisticUpdate.UpdatedAt).
+						Time("lastSyncAt", lastSyncTime).
+						Str("backendState", string(torrent.State)).
+						Msg("Clearing optimistic update - backend synced well past update")
+				} else if timeSinceUpdate > timeout {
+					// Safety net: still clear after the timeout if something went wrong
 					shouldClear = true
 					log.Debug().
 						Str("hash", hash).
 						Time("optimisticAt", optimisticUpdate.UpdatedAt).
 						Dur("timeSinceUpdate", timeSinceUpdate).
+						Dur("timeout", timeout).
 						Msg("Clearing stale optimistic update (safety net)")
 				} else {
 					// Debug: show why we're not clearing yet
@@ -1085,8 +1415,64 @@ func containsTagNoAlloc(tags string, target string) bool {
 	return false
 }
 
+// Search match tiers (lower is better). Field weight penalties only order matches within a tier.
+const (
+	searchScoreExact      = 0
+	searchScoreNormalized = 1
+	searchScoreAllWords   = 2
+	searchScoreFuzzy      = 3
+)
+
+// searchTierSpan is the score range of a match tier, wider than the largest field penalty so a
+// better tier always ranks first whatever the field weights
+const searchTierSpan = models.MaxSearchFieldWeight + 1
+
+// searchScore combines a match tier and a field penalty into a ranking score
+func searchScore(tier, penalty int) int {
+	return tier*searchTierSpan + penalty
+}
+
+// searchFieldPenalties holds the score added for a match on each field, derived from the
+// field weights so the most important field adds nothing
+type searchFieldPenalties struct {
+	name     int
+	category int
+	tags     int
+}
+
+func newSearchFieldPenalties(weights models.SearchWeights) searchFieldPenalties {
+	top := max(weights.Name, weights.Category, weights.Tags)
+	return searchFieldPenalties{
+		name:     top - weights.Name,
+		category: top - weights.Category,
+		tags:     top - weights.Tags,
+	}
+}
+
+// best returns the lowest penalty among the fields that matched
+func (p searchFieldPenalties) best(name, category, tags bool) (int, bool) {
+	penalty, ok := 0, false
+	for _, field := range []struct {
+		matched bool
+		penalty int
+	}{{name, p.name}, {category, p.category}, {tags, p.tags}} {
+		if field.matched && (!ok || field.penalty < penalty) {
+			penalty, ok = field.penalty, true
+		}
+	}
+	return penalty, ok
+}
+
+// torrentMatch is a torrent matched by a search together with its ranking score
+type torrentMatch struct {
+	torrent qbt.Torrent
+	score   int
+	method  string // for debugging
+}
+
 // filterTorrentsBySearch filters torrents by search string with smart matching
-func (sm *SyncManager) filterTorrentsBySearch(torrents []qbt.Torrent, search string) []qbt.Torrent {
+// Fuzzy name matches are only included when fuzzy is set.
+func (sm *SyncManager) filterTorrentsBySearch(torrents []qbt.Torrent, search string, fuzzy bool) []qbt.Torrent {
 	if search == "" {
 		return torrents
 	}
@@ -1096,16 +1482,48 @@ func (sm *SyncManager) filterTorrentsBySearch(torrents []qbt.Torrent, search str
 		return sm.filterTorrentsByGlob(torrents, search)
 	}
 
-	type torrentMatch struct {
-		torrent qbt.Torrent
-		score   int
-		method  string // for debugging
+	matches := sm.rankTorrentsBySearch(torrents, search, fuzzy)
+
+	// Extract just the torrents
+	filtered := make([]qbt.Torrent, len(matches))
+	for i, match := range matches {
+		filtered[i] = match.torrent
+		if i < 5 { // Log first 5 matches for debugging
+			log.Debug().
+				Str("name", match.torrent.Name).
+				Int("score", match.score).
+				Str("method", match.method).
+				Msg("Search match")
+		}
+	}
+
+	log.Debug().
+		Str("search", search).
+		Int("totalTorrents", len(torrents)).
+		Int("matchedTorrents", len(filtered)).
+		Msg("Search completed")
+
+	return filtered
+}
+
+// rankTorrentsBySearch scores torrents against a search string and returns the matches
+// sorted by score (lower is better). Glob patterns are matched with a score of 0. Without
+// allowFuzzy the fuzzy tier is skipped so only literal matches are returned.
+func (sm *SyncManager) rankTorrentsBySearch(torrents []qbt.Torrent, search string, allowFuzzy bool) []torrentMatch {
+	if strings.ContainsAny(search, "*?[") {
+		globbed := sm.filterTorrentsByGlob(torrents, search)
+		matches := make([]torrentMatch, len(globbed))
+		for i, torrent := range globbed {
+			matches[i] = torrentMatch{torrent: torrent, score: 0, method: "glob"}
+		}
+		return matches
 	}
 
 	var matches []torrentMatch
 	searchLower := strings.ToLower(search)
 	searchNormalized := normalizeForSearch(search)
 	searchWords := strings.Fields(searchNormalized)
+	penalties := newSearchFieldPenalties(sm.GetSearchWeights())
 
 	for _, torrent := range torrents {
 		// Method 1: Exact substring match (highest priority)
@@ -1113,12 +1531,14 @@ func (sm *SyncManager) filterTorrentsBySearch(torrents []qbt.Torrent, search str
 		categoryLower := strings.ToLower(torrent.Category)
 		tagsLower := strings.ToLower(torrent.Tags)
 
-		if strings.Contains(nameLower, searchLower) ||
-			strings.Contains(categoryLower, searchLower) ||
-			strings.Contains(tagsLower, searchLower) {
+		if penalty, ok := penalties.best(
+			strings.Contains(nameLower, searchLower),
+			strings.Contains(categoryLower, searchLower),
+			strings.Contains(tagsLower, searchLower),
+		); ok {
 			matches = append(matches, torrentMatch{
 				torrent: torrent,
-				score:   0, // Best score
+				score:   searchScore(searchScoreExact, penalty),
 				method:  "exact",
 			})
 			continue
@@ -1129,31 +1549,40 @@ func (sm *SyncManager) filterTorrentsBySearch(torrents []qbt.Torrent, search str
 		categoryNormalized := normalizeForSearch(torrent.Category)
 		tagsNormalized := normalizeForSearch(torrent.Tags)
 
-		if strings.Contains(nameNormalized, searchNormalized) ||
-			strings.Contains(categoryNormalized, searchNormalized) ||
-			strings.Contains(tagsNormalized, searchNormalized) {
+		if penalty, ok := penalties.best(
+			strings.Contains(nameNormalized, searchNormalized),
+			strings.Contains(categoryNormalized, searchNormalized),
+			strings.Contains(tagsNormalized, searchNormalized),
+		); ok {
 			matches = append(matches, torrentMatch{
 				torrent: torrent,
-				score:   1,
+				score:   searchScore(searchScoreNormalized, penalty),
 				method:  "normalized",
 			})
 			continue
 		}
 
-		// Method 3: All words present (for multi-word searches)
+		// Method 3: All words present (for multi-word searches). Each word counts with the best
+		// field it appears in, and the match is ranked by its least important word.
 		if len(searchWords) > 1 {
-			allFieldsNormalized := fmt.Sprintf("%s %s %s", nameNormalized, categoryNormalized, tagsNormalized)
 			allWordsFound := true
+			wordsPenalty := 0
 			for _, word := range searchWords {
-				if !strings.Contains(allFieldsNormalized, word) {
+				penalty, ok := penalties.best(
+					strings.Contains(nameNormalized, word),
+					strings.Contains(categoryNormalized, word),
+					strings.Contains(tagsNormalized, word),
+				)
+				if !ok {
 					allWordsFound = false
 					break
 				}
+				wordsPenalty = max(wordsPenalty, penalty)
 			}
 			if allWordsFound {
 				matches = append(matches, torrentMatch{
 					torrent: torrent,
-					score:   2,
+					score:   searchScore(searchScoreAllWords, wordsPenalty),
 					method:  "all-words",
 				})
 				continue
@@ -1162,13 +1591,13 @@ func (sm *SyncManager) filterTorrentsBySearch(torrents []qbt.Torrent, search str
 
 		// Method 4: Fuzzy match only on the normalized name (not the full text)
 		// This prevents matching random letter combinations across the entire text
-		if fuzzy.MatchNormalizedFold(searchNormalized, nameNormalized) {
+		if allowFuzzy && fuzzy.MatchNormalizedFold(searchNormalized, nameNormalized) {
 			score := fuzzy.RankMatchNormalizedFold(searchNormalized, nameNormalized)
 			// Only accept good fuzzy matches (score < 10 is quite good)
 			if score < 10 {
 				matches = append(matches, torrentMatch{
 					torrent: torrent,
-					score:   3 + score, // Fuzzy matches start at score 3
+					score:   searchScore(searchScoreFuzzy, penalties.name) + score,
 					method:  "fuzzy",
 				})
 			}
@@ -1176,30 +1605,11 @@ func (sm *SyncManager) filterTorrentsBySearch(torrents []qbt.Torrent, search str
 	}
 
 	// Sort by score (lower is better)
-	sort.Slice(matches, func(i, j int) bool {
+	sort.SliceStable(matches, func(i, j int) bool {
 		return matches[i].score < matches[j].score
 	})
 
-	// Extract just the torrents
-	filtered := make([]qbt.Torrent, len(matches))
-	for i, match := range matches {
-		filtered[i] = match.torrent
-		if i < 5 { // Log first 5 matches for debugging
-			log.Debug().
-				Str("name", match.torrent.Name).
-				Int("score", match.score).
-				Str("method", match.method).
-				Msg("Search match")
-		}
-	}
-
-	log.Debug().
-		Str("search", search).
-		Int("totalTorrents", len(torrents)).
-		Int("matchedTorrents", len(filtered)).
-		Msg("Search completed")
-
-	return filtered
+	return matches
 }
 
 // filterTorrentsByGlob filters torrents using glob pattern matching
@@ -1438,10 +1848,10 @@ var torrentStateCategories = map[qbt.TorrentFilter][]qbt.TorrentState{
 
 // Action state categories for optimistic update clearing
 var actionSuccessCategories = map[string]string{
-	"resume":       "active",
-	"force_resume": "active",
-	"pause":        "paused",
-	"recheck":      "checking",
+	"resume":      "active",
+	"resumeForce": "active",
+	"pause":       "paused",
+	"recheck":     "checking",
 }
 
 // shouldClearOptimisticUpdate checks if an optimistic update should be cleared based on the action and current state
@@ -1490,19 +1900,16 @@ func (sm *SyncManager) matchTorrentStatus(torrent qbt.Torrent, status string) bo
 		return true
 	case qbt.TorrentFilterCompleted:
 		return torrent.Progress == 1
+	case qbt.TorrentFilterActive:
+		return sm.isActive(torrent)
 	case qbt.TorrentFilterInactive:
 		// Inactive is the inverse of active
-		return !slices.Contains(torrentStateCategories[qbt.TorrentFilterActive], torrent.State)
+		return !sm.isActive(torrent)
 	case qbt.TorrentFilterRunning, qbt.TorrentFilterResumed:
-		// Running/Resumed means "not paused and not stopped"
-		pausedStates := torrentStateCategories[qbt.TorrentFilterPaused]
-		stoppedStates := torrentStateCategories[qbt.TorrentFilterStopped]
-		return !slices.Contains(pausedStates, torrent.State) && !slices.Contains(stoppedStates, torrent.State)
+		// Running/Resumed is the inverse of stopped
+		return !isStoppedState(torrent.State)
 	case qbt.TorrentFilterStopped, qbt.TorrentFilterPaused:
-		// Stopped/Paused includes both paused and stopped states
-		pausedStates := torrentStateCategories[qbt.TorrentFilterPaused]
-		stoppedStates := torrentStateCategories[qbt.TorrentFilterStopped]
-		return slices.Contains(pausedStates, torrent.State) || slices.Contains(stoppedStates, torrent.State)
+		return isStoppedState(torrent.State)
 	}
 
 	// For grouped status categories, check if state is in the category
@@ -1547,6 +1954,10 @@ func (sm *SyncManager) calculateStats(torrents []qbt.Torrent) *TorrentStats {
 		stats.TotalDownloadSpeed += int(torrent.DlSpeed)
 		stats.TotalUploadSpeed += int(torrent.UpSpeed)
 
+		if sm.isActive(torrent) {
+			stats.Active++
+		}
+
 		// Count states
 		switch torrent.State {
 		case qbt.TorrentStateDownloading, qbt.TorrentStateStalledDl, qbt.TorrentStateMetaDl, qbt.TorrentStateQueuedDl, qbt.TorrentStateForcedDl:
@@ -1565,8 +1976,22 @@ func (sm *SyncManager) calculateStats(torrents []qbt.Torrent) *TorrentStats {
 	return stats
 }
 
-// AddTags adds tags to the specified torrents (keeps existing tags)
+// AddTags adds comma-separated tags to the specified torrents (keeps existing tags).
+// Kept for callers that still send CSV; prefer AddTagList.
 func (sm *SyncManager) AddTags(ctx context.Context, instanceID int, hashes []string, tags string) error {
+	return sm.AddTagList(ctx, instanceID, hashes, SplitTags(tags))
+}
+
+// AddTagList adds tags to the specified torrents
+func (sm *SyncManager) AddTagList(ctx context.Context, instanceID int, hashes []string, tagList []string) error {
+	tags, err := joinTags(tagList)
+	if err != nil {
+		return err
+	}
+	if tags == "" {
+		return fmt.Errorf("%w: no tags provided", ErrInvalidTag)
+	}
+
 	// Get client and sync manager
 	client, syncManager, err := sm.getClientAndSyncManager(ctx, instanceID)
 	if err != nil {
@@ -1605,8 +2030,22 @@ func (sm *SyncManager) AddTags(ctx context.Context, instanceID int, hashes []str
 	return nil
 }
 
-// RemoveTags removes specific tags from the specified torrents
+// RemoveTags removes comma-separated tags from the specified torrents.
+// Kept for callers that still send CSV; prefer RemoveTagList.
 func (sm *SyncManager) RemoveTags(ctx context.Context, instanceID int, hashes []string, tags string) error {
+	return sm.RemoveTagList(ctx, instanceID, hashes, SplitTags(tags))
+}
+
+// RemoveTagList removes specific tags from the specified torrents
+func (sm *SyncManager) RemoveTagList(ctx context.Context, instanceID int, hashes []string, tagList []string) error {
+	tags, err := joinTags(tagList)
+	if err != nil {
+		return err
+	}
+	if tags == "" {
+		return fmt.Errorf("%w: no tags provided", ErrInvalidTag)
+	}
+
 	// Get client and sync manager
 	client, _, err := sm.getClientAndSyncManager(ctx, instanceID)
 	if err != nil {
@@ -1627,9 +2066,20 @@ func (sm *SyncManager) RemoveTags(ctx context.Context, instanceID int, hashes []
 	return nil
 }
 
-// SetTags sets tags on the specified torrents (replaces all existing tags)
-// This uses the new qBittorrent 5.1+ API if available, otherwise falls back to RemoveTags + AddTags
+// SetTags sets comma-separated tags on the specified torrents (replaces all existing tags).
+// Kept for callers that still send CSV; prefer SetTagList.
 func (sm *SyncManager) SetTags(ctx context.Context, instanceID int, hashes []string, tags string) error {
+	return sm.SetTagList(ctx, instanceID, hashes, SplitTags(tags))
+}
+
+// SetTagList replaces all tags of the specified torrents; an empty list clears them.
+// This uses the new qBittorrent 5.1+ API if available, otherwise falls back to RemoveTags + AddTags
+func (sm *SyncManager) SetTagList(ctx context.Context, instanceID int, hashes []string, tagList []string) error {
+	tags, err := joinTags(tagList)
+	if err != nil {
+		return err
+	}
+
 	client, err := sm.clientPool.GetClient(ctx, instanceID)
 	if err != nil {
 		return fmt.Errorf("failed to get client: %w", err)
@@ -1679,8 +2129,7 @@ func (sm *SyncManager) SetTags(ctx context.Context, instanceID int, hashes []str
 			if err := client.AddTagsCtx(ctx, hashes, tags); err != nil {
 				return fmt.Errorf("failed to add new tags during fallback: %w", err)
 			}
-			newTags := strings.Split(tags, ",")
-			log.Debug().Strs("addedTags", newTags).Msg("SetTags fallback: added new tags")
+			log.Debug().Strs("addedTags", tagList).Msg("SetTags fallback: added new tags")
 		}
 	}
 
@@ -1736,6 +2185,61 @@ func (sm *SyncManager) SetAutoTMM(ctx context.Context, instanceID int, hashes []
 	return nil
 }
 
+// ErrCategoryNotFound is returned when assigning a category that does not exist on the instance
+var ErrCategoryNotFound = errors.New("category not found")
+
+// SetCategoryWithAutoTMM sets the category and Auto-TMM of torrents in one step. When enabling,
+// the category is set first so qBittorrent moves the data to the category's save path; when
+// disabling, Auto-TMM is turned off first so the data stays where it is. An empty category
+// removes the category.
+func (sm *SyncManager) SetCategoryWithAutoTMM(ctx context.Context, instanceID int, hashes []string, category string, enableTMM bool) error {
+	client, syncManager, err := sm.getClientAndSyncManager(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+
+	if err := validateCategory(syncManager.GetCategories(), category); err != nil {
+		return err
+	}
+
+	if err := sm.validateTorrentsExist(client, hashes, "set category"); err != nil {
+		return err
+	}
+
+	if enableTMM {
+		if err := client.SetCategoryCtx(ctx, hashes, category); err != nil {
+			return err
+		}
+		if err := client.SetAutoManagementCtx(ctx, hashes, true); err != nil {
+			return err
+		}
+	} else {
+		if err := client.SetAutoManagementCtx(ctx, hashes, false); err != nil {
+			return err
+		}
+		if err := client.SetCategoryCtx(ctx, hashes, category); err != nil {
+			return err
+		}
+	}
+
+	// Apply optimistic updates to cache
+	sm.applyOptimisticCacheUpdate(instanceID, hashes, "setCategory", map[string]any{"category": category})
+	sm.applyOptimisticCacheUpdate(instanceID, hashes, "toggleAutoTMM", map[string]any{"enable": enableTMM})
+
+	return nil
+}
+
+// validateCategory checks that a category exists; the empty category always does
+func validateCategory(categories map[string]qbt.Category, category string) error {
+	if category == "" {
+		return nil
+	}
+	if _, ok := categories[category]; !ok {
+		return fmt.Errorf("%w: %s", ErrCategoryNotFound, category)
+	}
+	return nil
+}
+
 // CreateTags creates new tags
 func (sm *SyncManager) CreateTags(ctx context.Context, instanceID int, tags []string) error {
 	client, err := sm.clientPool.GetClient(ctx, instanceID)
@@ -1854,13 +2358,18 @@ func (sm *SyncManager) SetAppPreferences(ctx context.Context, instanceID int, pr
 	return nil
 }
 
-// AddPeersToTorrents adds peers to the specified torrents
-func (sm *SyncManager) AddPeersToTorrents(ctx context.Context, instanceID int, hashes []string, peers []string) error {
+// AddPeersToTorrents adds peers to the specified torrents. Adding peers by hand bypasses the
+// tracker, so private torrents are refused unless allowPrivate is set.
+func (sm *SyncManager) AddPeersToTorrents(ctx context.Context, instanceID int, hashes []string, peers []string, allowPrivate bool) error {
 	client, err := sm.clientPool.GetClient(ctx, instanceID)
 	if err != nil {
 		return fmt.Errorf("failed to get client: %w", err)
 	}
 
+	if err := client.guardPrivate(ctx, "addPeers", hashes, allowPrivate); err != nil {
+		return err
+	}
+
 	// Add peers using the qBittorrent client
 	if err := client.AddPeersForTorrentsCtx(ctx, hashes, peers); err != nil {
 		return fmt.Errorf("failed to add peers: %w", err)
@@ -2083,120 +2592,165 @@ func (sm *SyncManager) RemoveTorrentTrackers(ctx context.Context, instanceID int
 	return nil
 }
 
-// BulkEditTrackers edits tracker URLs for multiple torrents
-func (sm *SyncManager) BulkEditTrackers(ctx context.Context, instanceID int, hashes []string, oldURL, newURL string) error {
-	client, _, err := sm.getClientAndSyncManager(ctx, instanceID)
-	if err != nil {
-		return err
-	}
-
-	// Validate that torrents exist
-	if err := sm.validateTorrentsExist(client, hashes, "bulk edit trackers"); err != nil {
-		return err
-	}
-
-	updatedHashes := make([]string, 0, len(hashes))
+// TrackerResult is the outcome of a bulk tracker operation for one torrent
+type TrackerResult struct {
+	Hash    string `json:"hash"`
+	Success bool   `json:"success"`
+	Error   string `json:"error,omitempty"`
+}
 
+// applyPerTorrent runs apply for every hash, continuing past failures, and reports each result
+// to progress if set. It returns the result of each hash, the hashes that succeeded, and an
+// error when every hash failed or ctx was cancelled before all hashes were processed.
+func applyPerTorrent(ctx context.Context, hashes []string, operation string, progress JobProgress, apply func(hash string) error) ([]TrackerResult, []string, error) {
+	results := make([]TrackerResult, 0, len(hashes))
+	succeeded := make([]string, 0, len(hashes))
 	var lastErr error
 
-	// Edit trackers for each torrent
 	for _, hash := range hashes {
-		if err := client.EditTrackerCtx(ctx, hash, oldURL, newURL); err != nil {
+		if err := ctx.Err(); err != nil {
+			return results, succeeded, err
+		}
+
+		err := apply(hash)
+		if progress != nil {
+			progress(err != nil)
+		}
+		if err != nil {
 			// Log error but continue with other torrents
-			log.Error().Err(err).Str("hash", hash).Msg("Failed to edit tracker for torrent")
+			log.Error().Err(err).Str("hash", hash).Msgf("Failed to %s for torrent", operation)
+			results = append(results, TrackerResult{Hash: hash, Error: err.Error()})
 			lastErr = err
 			continue
 		}
-		updatedHashes = append(updatedHashes, hash)
+		results = append(results, TrackerResult{Hash: hash, Success: true})
+		succeeded = append(succeeded, hash)
 	}
 
-	if len(updatedHashes) == 0 {
+	if len(succeeded) == 0 {
 		if lastErr != nil {
-			return fmt.Errorf("failed to edit trackers: %w", lastErr)
+			return results, nil, fmt.Errorf("failed to %s: %w", operation, lastErr)
 		}
-		return fmt.Errorf("failed to edit trackers")
+		return results, nil, fmt.Errorf("failed to %s", operation)
 	}
 
-	sm.recordTrackerTransition(client, oldURL, newURL, updatedHashes)
+	return results, succeeded, nil
+}
+
+// BulkEditTrackers edits tracker URLs for multiple torrents. It returns the result of each
+// torrent; the error is only set when no torrent could be updated.
+func (sm *SyncManager) BulkEditTrackers(ctx context.Context, instanceID int, hashes []string, oldURL, newURL string) ([]TrackerResult, error) {
+	return sm.bulkEditTrackers(ctx, instanceID, hashes, oldURL, newURL, nil)
+}
 
-	// Trigger a sync so future read operations see the updated tracker list
-	sm.syncAfterModification(instanceID, client, "bulk_edit_trackers")
+// BulkAddTrackers adds trackers to multiple torrents. It returns the result of each torrent;
+// the error is only set when no torrent could be updated.
+func (sm *SyncManager) BulkAddTrackers(ctx context.Context, instanceID int, hashes []string, urls string) ([]TrackerResult, error) {
+	return sm.bulkAddTrackers(ctx, instanceID, hashes, urls, nil)
+}
 
-	return nil
+// BulkRemoveTrackers removes trackers from multiple torrents. It returns the result of each
+// torrent; the error is only set when no torrent could be updated.
+func (sm *SyncManager) BulkRemoveTrackers(ctx context.Context, instanceID int, hashes []string, urls string) ([]TrackerResult, error) {
+	return sm.bulkRemoveTrackers(ctx, instanceID, hashes, urls, nil)
 }
 
-// BulkAddTrackers adds trackers to multiple torrents
-func (sm *SyncManager) BulkAddTrackers(ctx context.Context, instanceID int, hashes []string, urls string) error {
+func (sm *SyncManager) bulkEditTrackers(ctx context.Context, instanceID int, hashes []string, oldURL, newURL string, progress JobProgress) ([]TrackerResult, error) {
 	client, _, err := sm.getClientAndSyncManager(ctx, instanceID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Validate that torrents exist
-	if err := sm.validateTorrentsExist(client, hashes, "bulk add trackers"); err != nil {
-		return err
+	if err := sm.validateTorrentsExist(client, hashes, "bulk edit trackers"); err != nil {
+		return nil, err
 	}
 
-	var success bool
-	var lastErr error
+	results, updatedHashes, err := applyPerTorrent(ctx, hashes, "edit trackers", progress, func(hash string) error {
+		return client.EditTrackerCtx(ctx, hash, oldURL, newURL)
+	})
+	if len(updatedHashes) > 0 {
+		sm.recordTrackerTransition(client, oldURL, newURL, updatedHashes)
 
-	// Add trackers to each torrent
-	for _, hash := range hashes {
-		if err := client.AddTrackersCtx(ctx, hash, urls); err != nil {
-			// Log error but continue with other torrents
-			log.Error().Err(err).Str("hash", hash).Msg("Failed to add trackers to torrent")
-			lastErr = err
-			continue
-		}
-		success = true
+		// Trigger a sync so future read operations see the updated tracker list
+		sm.syncAfterModification(instanceID, client, "bulk_edit_trackers")
 	}
 
-	if !success {
-		if lastErr != nil {
-			return fmt.Errorf("failed to add trackers: %w", lastErr)
-		}
-		return fmt.Errorf("failed to add trackers")
+	return results, err
+}
+
+func (sm *SyncManager) bulkAddTrackers(ctx context.Context, instanceID int, hashes []string, urls string, progress JobProgress) ([]TrackerResult, error) {
+	client, _, err := sm.getClientAndSyncManager(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate that torrents exist
+	if err := sm.validateTorrentsExist(client, hashes, "bulk add trackers"); err != nil {
+		return nil, err
 	}
 
-	sm.syncAfterModification(instanceID, client, "bulk_add_trackers")
+	results, updatedHashes, err := applyPerTorrent(ctx, hashes, "add trackers", progress, func(hash string) error {
+		return client.AddTrackersCtx(ctx, hash, urls)
+	})
+	if len(updatedHashes) > 0 {
+		sm.syncAfterModification(instanceID, client, "bulk_add_trackers")
+	}
 
-	return nil
+	return results, err
 }
 
-// BulkRemoveTrackers removes trackers from multiple torrents
-func (sm *SyncManager) BulkRemoveTrackers(ctx context.Context, instanceID int, hashes []string, urls string) error {
+func (sm *SyncManager) bulkRemoveTrackers(ctx context.Context, instanceID int, hashes []string, urls string, progress JobProgress) ([]TrackerResult, error) {
 	client, _, err := sm.getClientAndSyncManager(ctx, instanceID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Validate that torrents exist
 	if err := sm.validateTorrentsExist(client, hashes, "bulk remove trackers"); err != nil {
-		return err
+		return nil, err
 	}
 
-	var success bool
-	var lastErr error
-
-	// Remove trackers from each torrent
-	for _, hash := range hashes {
-		if err := client.RemoveTrackersCtx(ctx, hash, urls); err != nil {
-			// Log error but continue with other torrents
-			log.Error().Err(err).Str("hash", hash).Msg("Failed to remove trackers from torrent")
-			lastErr = err
-			continue
-		}
-		success = true
+	results, updatedHashes, err := applyPerTorrent(ctx, hashes, "remove trackers", progress, func(hash string) error {
+		return client.RemoveTrackersCtx(ctx, hash, urls)
+	})
+	if len(updatedHashes) > 0 {
+		sm.syncAfterModification(instanceID, client, "bulk_remove_trackers")
 	}
 
-	if !success {
-		if lastErr != nil {
-			return fmt.Errorf("failed to remove trackers: %w", lastErr)
+	return results, err
+}
+
+// TrackerJobRequest describes a per-torrent tracker operation to run as a background job
+type TrackerJobRequest struct {
+	Action string // editTrackers, addTrackers or removeTrackers
+	OldURL string // For editTrackers
+	NewURL string // For editTrackers
+	URLs   string // For addTrackers/removeTrackers
+}
+
+// StartTrackerJob starts a bulk tracker edit, add or remove in the background and returns
+// the job immediately. The job's result is the per-torrent []TrackerResult.
+func (sm *SyncManager) StartTrackerJob(instanceID int, hashes []string, req TrackerJobRequest) (*Job, error) {
+	var run func(ctx context.Context, progress JobProgress) ([]TrackerResult, error)
+	switch req.Action {
+	case "editTrackers":
+		run = func(ctx context.Context, progress JobProgress) ([]TrackerResult, error) {
+			return sm.bulkEditTrackers(ctx, instanceID, hashes, req.OldURL, req.NewURL, progress)
+		}
+	case "addTrackers":
+		run = func(ctx context.Context, progress JobProgress) ([]TrackerResult, error) {
+			return sm.bulkAddTrackers(ctx, instanceID, hashes, req.URLs, progress)
 		}
-		return fmt.Errorf("failed to remove trackers")
+	case "removeTrackers":
+		run = func(ctx context.Context, progress JobProgress) ([]TrackerResult, error) {
+			return sm.bulkRemoveTrackers(ctx, instanceID, hashes, req.URLs, progress)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported tracker job action: %s", req.Action)
 	}
 
-	sm.syncAfterModification(instanceID, client, "bulk_remove_trackers")
-
-	return nil
+	return sm.startJob(instanceID, req.Action, len(hashes), func(ctx context.Context, progress JobProgress) (any, error) {
+		return run(ctx, progress)
+	}), nil
 }
@@ -0,0 +1,47 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidTag is returned when a tag cannot be sent to qBittorrent
+var ErrInvalidTag = errors.New("invalid tag")
+
+// SplitTags splits a comma-separated tag string into trimmed, non-empty tags
+func SplitTags(tags string) []string {
+	var list []string
+	for tag := range strings.SplitSeq(tags, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			list = append(list, tag)
+		}
+	}
+	return list
+}
+
+// joinTags builds the comma-separated tag string the WebAPI expects. Tags are trimmed,
+// empty tags and duplicates are dropped. qBittorrent has no way to escape a comma, so tags
+// containing one are rejected instead of being silently split into several tags.
+func joinTags(tags []string) (string, error) {
+	seen := make(map[string]struct{}, len(tags))
+	list := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			continue
+		}
+		if strings.Contains(tag, ",") {
+			return "", fmt.Errorf("%w: %q contains a comma", ErrInvalidTag, tag)
+		}
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+		seen[tag] = struct{}{}
+		list = append(list, tag)
+	}
+	return strings.Join(list, ","), nil
+}
@@ -0,0 +1,29 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitTags(t *testing.T) {
+	assert.Equal(t, []string{"movies", "4k seeds"}, SplitTags(" movies, ,4k seeds ,"))
+	assert.Empty(t, SplitTags(""))
+}
+
+func TestJoinTags(t *testing.T) {
+	tags, err := joinTags([]string{" movies", "high ratio", "", "movies"})
+	require.NoError(t, err)
+	assert.Equal(t, "movies,high ratio", tags)
+
+	tags, err = joinTags(nil)
+	require.NoError(t, err)
+	assert.Empty(t, tags)
+
+	_, err = joinTags([]string{"1,000 seeds"})
+	assert.ErrorIs(t, err, ErrInvalidTag)
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/lithammer/fuzzysearch/fuzzy"
+)
+
+// maxTagSuggestions caps the number of tags returned for autocomplete
+const maxTagSuggestions = 20
+
+// TagSuggestion is an existing tag with the number of torrents using it
+type TagSuggestion struct {
+	Tag   string `json:"tag"`
+	Count int    `json:"count"`
+}
+
+// GetTagSuggestions returns existing tags starting with prefix (case-insensitive), most used
+// first. When no tag starts with the prefix, tags fuzzily matching it are returned instead so
+// typos still surface the existing tag rather than creating a near-duplicate.
+func (sm *SyncManager) GetTagSuggestions(ctx context.Context, instanceID int, prefix string) ([]TagSuggestion, error) {
+	_, syncManager, err := sm.getClientAndSyncManager(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := sm.GetTorrentCounts(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return suggestTags(syncManager.GetTags(), counts.Tags, prefix, maxTagSuggestions), nil
+}
+
+// suggestTags ranks tags against prefix using usage counts (tag -> torrents)
+func suggestTags(tags []string, usage map[string]int, prefix string, limit int) []TagSuggestion {
+	prefix = strings.ToLower(strings.TrimSpace(prefix))
+
+	byUsage := func(a, b TagSuggestion) int {
+		if c := cmp.Compare(b.Count, a.Count); c != 0 {
+			return c
+		}
+		return strings.Compare(strings.ToLower(a.Tag), strings.ToLower(b.Tag))
+	}
+
+	suggestions := make([]TagSuggestion, 0)
+	for _, tag := range tags {
+		if tag != "" && strings.HasPrefix(strings.ToLower(tag), prefix) {
+			suggestions = append(suggestions, TagSuggestion{Tag: tag, Count: usage[tag]})
+		}
+	}
+	slices.SortFunc(suggestions, byUsage)
+
+	if len(suggestions) == 0 && prefix != "" {
+		ranks := make(map[string]int)
+		for _, tag := range tags {
+			if tag != "" && fuzzy.MatchNormalizedFold(prefix, tag) {
+				ranks[tag] = fuzzy.RankMatchNormalizedFold(prefix, tag)
+				suggestions = append(suggestions, TagSuggestion{Tag: tag, Count: usage[tag]})
+			}
+		}
+		// Closest matches first, usage breaks ties
+		slices.SortFunc(suggestions, func(a, b TagSuggestion) int {
+			if c := cmp.Compare(ranks[a.Tag], ranks[b.Tag]); c != 0 {
+				return c
+			}
+			return byUsage(a, b)
+		})
+	}
+
+	if limit > 0 && len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+
+	return suggestions
+}
@@ -0,0 +1,46 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSuggestTags(t *testing.T) {
+	tags := []string{"Movies", "movies-4k", "music", "tv", "unused"}
+	usage := map[string]int{"Movies": 3, "movies-4k": 10, "music": 1, "tv": 5, "": 7}
+
+	tagNames := func(suggestions []TagSuggestion) []string {
+		names := make([]string, len(suggestions))
+		for i, s := range suggestions {
+			names[i] = s.Tag
+		}
+		return names
+	}
+
+	t.Run("prefix match ordered by usage", func(t *testing.T) {
+		got := suggestTags(tags, usage, "MOV", 0)
+		assert.Equal(t, []TagSuggestion{{Tag: "movies-4k", Count: 10}, {Tag: "Movies", Count: 3}}, got)
+	})
+
+	t.Run("empty prefix returns all tags", func(t *testing.T) {
+		got := suggestTags(tags, usage, "", 0)
+		assert.Equal(t, []string{"movies-4k", "tv", "Movies", "music", "unused"}, tagNames(got))
+	})
+
+	t.Run("fuzzy fallback", func(t *testing.T) {
+		got := suggestTags(tags, usage, "mvs", 0)
+		assert.Equal(t, []string{"Movies", "movies-4k"}, tagNames(got))
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		assert.Len(t, suggestTags(tags, usage, "", 2), 2)
+	})
+
+	t.Run("no match", func(t *testing.T) {
+		assert.Empty(t, suggestTags(tags, usage, "xyz", 0))
+	})
+}
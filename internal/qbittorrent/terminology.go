@@ -0,0 +1,65 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"maps"
+	"slices"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+)
+
+// Canonical names of the run states. qBittorrent 5 renamed paused to stopped and resumed to
+// running; qui uses the new names whatever the instance's version and accepts the old ones.
+const (
+	StatusStopped = "stopped"
+	StatusRunning = "running"
+)
+
+// legacyStatusNames maps the pre-5.0 status names to their canonical names
+var legacyStatusNames = map[string]string{
+	"paused":  StatusStopped,
+	"resumed": StatusRunning,
+}
+
+// CanonicalStatus returns the canonical name of a status filter. Names other than the legacy
+// run states are returned unchanged.
+func CanonicalStatus(status string) string {
+	if canonical, ok := legacyStatusNames[status]; ok {
+		return canonical
+	}
+	return status
+}
+
+// isStoppedState reports whether a torrent is stopped. The paused category holds the paused
+// states of older versions and the stopped states of qBittorrent 5.
+func isStoppedState(state qbt.TorrentState) bool {
+	return slices.Contains(torrentStateCategories[qbt.TorrentFilterPaused], state)
+}
+
+// canonicalStatusCounts returns the status counts without the legacy names
+func canonicalStatusCounts(counts map[string]int) map[string]int {
+	canonical := maps.Clone(counts)
+	for legacy := range legacyStatusNames {
+		delete(canonical, legacy)
+	}
+	return canonical
+}
+
+// StatusTerms is the wording an instance's qBittorrent version uses for the run states and the
+// actions that change them, for showing to users. The API itself always uses the canonical names.
+type StatusTerms struct {
+	Stopped string `json:"stopped"`
+	Running string `json:"running"`
+	Stop    string `json:"stop"`
+	Start   string `json:"start"`
+}
+
+// statusTerms picks the wording from the instance's stop/start capability
+func statusTerms(capabilities Capabilities) StatusTerms {
+	if capabilities.StopStart {
+		return StatusTerms{Stopped: "stopped", Running: "running", Stop: "stop", Start: "start"}
+	}
+	return StatusTerms{Stopped: "paused", Running: "resumed", Stop: "pause", Start: "resume"}
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"testing"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanonicalStatus(t *testing.T) {
+	assert.Equal(t, StatusStopped, CanonicalStatus("paused"))
+	assert.Equal(t, StatusRunning, CanonicalStatus("resumed"))
+	assert.Equal(t, StatusStopped, CanonicalStatus("stopped"))
+	assert.Equal(t, "downloading", CanonicalStatus("downloading"))
+}
+
+func TestStatusCountsUseBothVocabularies(t *testing.T) {
+	sm := &SyncManager{}
+	torrents := []qbt.Torrent{
+		{Hash: "a", State: qbt.TorrentStatePausedUp},
+		{Hash: "b", State: qbt.TorrentStateStoppedDl},
+		{Hash: "c", State: qbt.TorrentStateUploading, Progress: 1},
+	}
+
+	counts := sm.calculateCountsFromTorrentsWithTrackers(nil, torrents, nil)
+
+	assert.Equal(t, 2, counts.Status["paused"])
+	assert.Equal(t, 2, counts.Status["stopped"])
+	assert.Equal(t, 1, counts.Status["resumed"])
+	assert.Equal(t, 2, counts.StatusCanonical[StatusStopped])
+	assert.Equal(t, 1, counts.StatusCanonical[StatusRunning])
+	assert.NotContains(t, counts.StatusCanonical, "paused")
+	assert.NotContains(t, counts.StatusCanonical, "resumed")
+}
+
+func TestStatusTerms(t *testing.T) {
+	assert.Equal(t, StatusTerms{Stopped: "stopped", Running: "running", Stop: "stop", Start: "start"}, statusTerms(Capabilities{StopStart: true}))
+	assert.Equal(t, StatusTerms{Stopped: "paused", Running: "resumed", Stop: "pause", Start: "resume"}, statusTerms(Capabilities{}))
+}
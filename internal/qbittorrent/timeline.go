@@ -0,0 +1,244 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+	"github.com/rs/zerolog/log"
+
+	"github.com/autobrr/qui/internal/models"
+)
+
+const (
+	// timelineCollectInterval is how often sync snapshots are diffed for new events
+	timelineCollectInterval = 30 * time.Second
+	// timelineRetention is how long torrent events are kept
+	timelineRetention = 90 * 24 * time.Hour
+	// timelinePruneInterval is how often old events and events of removed torrents are pruned
+	timelinePruneInterval = time.Hour
+)
+
+// torrentSnapshot is the part of a torrent's state the timeline tracks between collections
+type torrentSnapshot struct {
+	state     qbt.TorrentState
+	completed bool
+}
+
+// TimelineCollector records notable state transitions of torrents (added, started, paused,
+// completed, errored) by diffing successive sync snapshots of connected instances. The first
+// snapshot of an instance only sets a baseline, so nothing is recorded for torrents that were
+// already present when qui started.
+type TimelineCollector struct {
+	store       *models.TorrentEventStore
+	syncManager *SyncManager
+	snapshots   map[int]map[string]torrentSnapshot
+	lastPrune   time.Time
+	listeners   []TorrentEventListener
+}
+
+// TorrentEventListener is called with the new events of an instance after each collection
+type TorrentEventListener func(ctx context.Context, instanceID int, events []models.TorrentEvent)
+
+func NewTimelineCollector(store *models.TorrentEventStore, syncManager *SyncManager) *TimelineCollector {
+	return &TimelineCollector{
+		store:       store,
+		syncManager: syncManager,
+		snapshots:   make(map[int]map[string]torrentSnapshot),
+	}
+}
+
+// GetTorrentTimeline returns the recorded events of a torrent, oldest first
+func (c *TimelineCollector) GetTorrentTimeline(ctx context.Context, instanceID int, hash string) ([]models.TorrentEvent, error) {
+	if hash == "" {
+		return nil, fmt.Errorf("torrent hash is required")
+	}
+
+	return c.store.ListForTorrent(ctx, instanceID, hash)
+}
+
+// OnEvents registers a listener for new events. Listeners run on the collector's goroutine and
+// must be registered before Start.
+func (c *TimelineCollector) OnEvents(listener TorrentEventListener) {
+	c.listeners = append(c.listeners, listener)
+}
+
+// Start runs the collector until ctx is cancelled
+func (c *TimelineCollector) Start(ctx context.Context) {
+	ticker := time.NewTicker(timelineCollectInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.collect(ctx, time.Now())
+		}
+	}
+}
+
+// collect diffs the current torrents of every connected instance against the previous snapshot
+func (c *TimelineCollector) collect(ctx context.Context, now time.Time) {
+	prune := now.Sub(c.lastPrune) >= timelinePruneInterval
+	seen := make(map[int]struct{})
+
+	// Only clients already in the pool are collected; the collector never opens connections
+	for _, client := range c.syncManager.clientPool.connectedClients() {
+		syncManager := client.GetSyncManager()
+		if syncManager == nil || syncManager.LastSyncTime().IsZero() {
+			continue
+		}
+
+		instanceID := client.GetInstanceID()
+		seen[instanceID] = struct{}{}
+
+		torrents := syncManager.GetTorrentMap(qbt.TorrentFilterOptions{})
+		current := snapshotTorrents(torrents)
+		if previous, ok := c.snapshots[instanceID]; ok {
+			events := diffTorrentSnapshots(instanceID, previous, current, torrents, now)
+			if err := c.store.Insert(ctx, events); err != nil {
+				log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to record torrent events")
+			}
+			if len(events) > 0 {
+				for _, listener := range c.listeners {
+					listener(ctx, instanceID, events)
+				}
+			}
+		}
+		c.snapshots[instanceID] = current
+
+		if prune {
+			c.pruneRemoved(ctx, instanceID, current)
+		}
+	}
+
+	// Forget instances that were removed or disconnected so they get a fresh baseline
+	for instanceID := range c.snapshots {
+		if _, ok := seen[instanceID]; !ok {
+			delete(c.snapshots, instanceID)
+		}
+	}
+
+	if prune {
+		c.lastPrune = now
+		if removed, err := c.store.DeleteOlderThan(ctx, now.Add(-timelineRetention)); err != nil {
+			log.Error().Err(err).Msg("Failed to prune old torrent events")
+		} else if removed > 0 {
+			log.Debug().Int64("removed", removed).Msg("Pruned old torrent events")
+		}
+	}
+}
+
+// pruneRemoved deletes the events of torrents that no longer exist on the instance
+func (c *TimelineCollector) pruneRemoved(ctx context.Context, instanceID int, current map[string]torrentSnapshot) {
+	hashes, err := c.store.ListHashes(ctx, instanceID)
+	if err != nil {
+		log.Error().Err(err).Int("instanceID", instanceID).Msg("Failed to list torrents with events")
+		return
+	}
+
+	for _, hash := range hashes {
+		if _, exists := current[hash]; exists {
+			continue
+		}
+		if err := c.store.DeleteForTorrent(ctx, instanceID, hash); err != nil {
+			log.Error().Err(err).Int("instanceID", instanceID).Str("hash", hash).Msg("Failed to prune torrent events")
+		}
+	}
+}
+
+func snapshotTorrents(torrents map[string]qbt.Torrent) map[string]torrentSnapshot {
+	snapshots := make(map[string]torrentSnapshot, len(torrents))
+	for hash, torrent := range torrents {
+		snapshots[hash] = torrentSnapshot{
+			state:     torrent.State,
+			completed: torrent.Progress >= 1,
+		}
+	}
+	return snapshots
+}
+
+// diffTorrentSnapshots returns the events between two snapshots of an instance. Added and
+// completed events use qBittorrent's own timestamps when available, so they are accurate even
+// when the transition happened between collections.
+func diffTorrentSnapshots(instanceID int, previous, current map[string]torrentSnapshot, torrents map[string]qbt.Torrent, now time.Time) []models.TorrentEvent {
+	var events []models.TorrentEvent
+	record := func(hash, event string, state qbt.TorrentState, at time.Time) {
+		events = append(events, models.TorrentEvent{
+			InstanceID: instanceID,
+			Hash:       hash,
+			Event:      event,
+			State:      string(state),
+			OccurredAt: at,
+		})
+	}
+
+	hashes := make([]string, 0, len(current))
+	for hash := range current {
+		hashes = append(hashes, hash)
+	}
+	slices.Sort(hashes)
+
+	for _, hash := range hashes {
+		snapshot := current[hash]
+		torrent := torrents[hash]
+
+		before, existed := previous[hash]
+		if !existed {
+			record(hash, models.TorrentEventAdded, snapshot.state, unixOr(torrent.AddedOn, now))
+			continue
+		}
+
+		if snapshot.completed && !before.completed {
+			record(hash, models.TorrentEventCompleted, snapshot.state, unixOr(torrent.CompletionOn, now))
+		}
+
+		if event := phaseTransition(before.state, snapshot.state); event != "" {
+			record(hash, event, snapshot.state, now)
+		}
+	}
+
+	return events
+}
+
+// phaseTransition returns the event for a state change, or "" when the change is not notable
+func phaseTransition(from, to qbt.TorrentState) string {
+	fromPhase, toPhase := torrentPhase(from), torrentPhase(to)
+	if fromPhase == toPhase {
+		return ""
+	}
+
+	switch toPhase {
+	case models.TorrentEventErrored:
+		return models.TorrentEventErrored
+	case models.TorrentEventPaused:
+		return models.TorrentEventPaused
+	default:
+		return models.TorrentEventStarted
+	}
+}
+
+// torrentPhase groups states into errored, paused and running
+func torrentPhase(state qbt.TorrentState) string {
+	switch {
+	case slices.Contains(torrentStateCategories[qbt.TorrentFilterError], state):
+		return models.TorrentEventErrored
+	case slices.Contains(torrentStateCategories[qbt.TorrentFilterPaused], state):
+		return models.TorrentEventPaused
+	default:
+		return "running"
+	}
+}
+
+func unixOr(seconds int64, fallback time.Time) time.Time {
+	if seconds <= 0 {
+		return fallback
+	}
+	return time.Unix(seconds, 0)
+}
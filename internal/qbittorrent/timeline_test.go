@@ -0,0 +1,52 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"testing"
+	"time"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/autobrr/qui/internal/models"
+)
+
+func TestDiffTorrentSnapshots(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	addedOn := now.Add(-10 * time.Second)
+	completedOn := now.Add(-5 * time.Second)
+
+	previous := snapshotTorrents(map[string]qbt.Torrent{
+		"downloading": {State: qbt.TorrentStateDownloading, Progress: 0.5},
+		"seeding":     {State: qbt.TorrentStateUploading, Progress: 1},
+		"paused":      {State: qbt.TorrentStatePausedDl, Progress: 0.2},
+		"stalled":     {State: qbt.TorrentStateStalledDl, Progress: 0.1},
+	})
+
+	torrents := map[string]qbt.Torrent{
+		"downloading": {State: qbt.TorrentStateUploading, Progress: 1, CompletionOn: completedOn.Unix()},
+		"seeding":     {State: qbt.TorrentStateStoppedUp, Progress: 1},
+		"paused":      {State: qbt.TorrentStateDownloading, Progress: 0.2},
+		"stalled":     {State: qbt.TorrentStateDownloading, Progress: 0.3},
+		"new":         {State: qbt.TorrentStateMetaDl, AddedOn: addedOn.Unix()},
+	}
+
+	events := diffTorrentSnapshots(1, previous, snapshotTorrents(torrents), torrents, now)
+
+	require.Len(t, events, 4)
+	assert.Equal(t, models.TorrentEvent{InstanceID: 1, Hash: "downloading", Event: models.TorrentEventCompleted, State: "uploading", OccurredAt: completedOn}, events[0])
+	assert.Equal(t, models.TorrentEvent{InstanceID: 1, Hash: "new", Event: models.TorrentEventAdded, State: "metaDL", OccurredAt: addedOn}, events[1])
+	assert.Equal(t, models.TorrentEvent{InstanceID: 1, Hash: "paused", Event: models.TorrentEventStarted, State: "downloading", OccurredAt: now}, events[2])
+	assert.Equal(t, models.TorrentEvent{InstanceID: 1, Hash: "seeding", Event: models.TorrentEventPaused, State: "stoppedUP", OccurredAt: now}, events[3])
+}
+
+func TestPhaseTransition(t *testing.T) {
+	assert.Equal(t, models.TorrentEventErrored, phaseTransition(qbt.TorrentStateUploading, qbt.TorrentStateMissingFiles))
+	assert.Equal(t, models.TorrentEventStarted, phaseTransition(qbt.TorrentStateError, qbt.TorrentStateCheckingDl))
+	assert.Equal(t, models.TorrentEventPaused, phaseTransition(qbt.TorrentStateError, qbt.TorrentStatePausedUp))
+	assert.Empty(t, phaseTransition(qbt.TorrentStatePausedUp, qbt.TorrentStateStoppedUp))
+	assert.Empty(t, phaseTransition(qbt.TorrentStateStalledDl, qbt.TorrentStateDownloading))
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"fmt"
+	"time"
+)
+
+// LoadTimeZone resolves an IANA time zone name. An empty name is the server's local zone.
+func LoadTimeZone(name string) (*time.Location, error) {
+	if name == "" {
+		return time.Local, nil
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("unknown time zone %q", name)
+	}
+	return loc, nil
+}
+
+// SetTimeZone sets the default time zone day, week and month boundaries are computed in.
+// An empty name is the server's local zone.
+func (sm *SyncManager) SetTimeZone(name string) error {
+	loc, err := LoadTimeZone(name)
+	if err != nil {
+		return err
+	}
+	sm.timeZone.Store(loc)
+	return nil
+}
+
+// location returns loc, or the default time zone when loc is nil
+func (sm *SyncManager) location(loc *time.Location) *time.Location {
+	if loc != nil {
+		return loc
+	}
+	if loc := sm.timeZone.Load(); loc != nil {
+		return loc
+	}
+	return time.Local
+}
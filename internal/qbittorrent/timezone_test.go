@@ -0,0 +1,39 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadTimeZone(t *testing.T) {
+	loc, err := LoadTimeZone("")
+	require.NoError(t, err)
+	assert.Equal(t, time.Local, loc)
+
+	loc, err = LoadTimeZone("UTC")
+	require.NoError(t, err)
+	assert.Equal(t, "UTC", loc.String())
+
+	_, err = LoadTimeZone("Mars/Olympus_Mons")
+	assert.Error(t, err)
+}
+
+func TestSyncManagerLocation(t *testing.T) {
+	sm := &SyncManager{}
+	assert.Equal(t, time.Local, sm.location(nil), "defaults to the server's local zone")
+
+	require.NoError(t, sm.SetTimeZone("UTC"))
+	assert.Equal(t, "UTC", sm.location(nil).String())
+
+	override := time.FixedZone("override", -5*60*60)
+	assert.Equal(t, override, sm.location(override), "request zone wins over the configured one")
+
+	assert.Error(t, sm.SetTimeZone("Nowhere/Invalid"))
+	assert.Equal(t, "UTC", sm.location(nil).String(), "invalid zones keep the previous one")
+}
@@ -0,0 +1,257 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"archive/zip"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// DefaultBackupRetention is how many backups per instance are kept when not configured
+	DefaultBackupRetention = 7
+
+	backupTimeLayout = "20060102-150405"
+	// backupIdleCheck bounds how long the worker sleeps while scheduled backups are disabled
+	backupIdleCheck = time.Hour
+)
+
+// TorrentBackup describes a .torrent backup archive written to disk
+type TorrentBackup struct {
+	InstanceID int       `json:"instanceId"`
+	Path       string    `json:"path"`
+	Torrents   int       `json:"torrents"`
+	Failed     []string  `json:"failed,omitempty"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+// TorrentBackupScheduler periodically writes the .torrent files of every connected instance
+// into a zip archive, keeping the most recent archives per instance. Backups can also be
+// triggered manually with BackupInstance.
+type TorrentBackupScheduler struct {
+	syncManager *SyncManager
+
+	mu        sync.RWMutex
+	interval  time.Duration
+	retention int
+	dir       string
+	reset     chan struct{}
+
+	// runMu serializes backups so a manual run never races the scheduled one
+	runMu sync.Mutex
+}
+
+// NewTorrentBackupScheduler creates a scheduler writing to dir. An interval of zero
+// disables scheduled backups; manual backups still work.
+func NewTorrentBackupScheduler(syncManager *SyncManager, dir string, interval time.Duration, retention int) *TorrentBackupScheduler {
+	s := &TorrentBackupScheduler{
+		syncManager: syncManager,
+		reset:       make(chan struct{}, 1),
+	}
+	s.SetConfig(dir, interval, retention)
+	return s
+}
+
+// SetConfig changes the target directory, interval and retention and reschedules the next run
+func (s *TorrentBackupScheduler) SetConfig(dir string, interval time.Duration, retention int) {
+	if retention <= 0 {
+		retention = DefaultBackupRetention
+	}
+	interval = max(interval, 0)
+
+	s.mu.Lock()
+	s.dir = dir
+	s.interval = interval
+	s.retention = retention
+	s.mu.Unlock()
+
+	select {
+	case s.reset <- struct{}{}:
+	default:
+	}
+}
+
+func (s *TorrentBackupScheduler) config() (string, time.Duration, int) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.dir, s.interval, s.retention
+}
+
+// Start runs the scheduler until ctx is cancelled. The first backup runs one interval after start.
+func (s *TorrentBackupScheduler) Start(ctx context.Context) {
+	timer := time.NewTimer(s.nextDelay())
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.reset:
+			if !timer.Stop() {
+				select {
+				case <-timer.C:
+				default:
+				}
+			}
+			timer.Reset(s.nextDelay())
+		case <-timer.C:
+			if _, interval, _ := s.config(); interval > 0 {
+				s.backupAll(ctx)
+			}
+			timer.Reset(s.nextDelay())
+		}
+	}
+}
+
+func (s *TorrentBackupScheduler) nextDelay() time.Duration {
+	if _, interval, _ := s.config(); interval > 0 {
+		return interval
+	}
+	return backupIdleCheck
+}
+
+func (s *TorrentBackupScheduler) backupAll(ctx context.Context) {
+	for _, client := range s.syncManager.clientPool.connectedClients() {
+		instanceID := client.GetInstanceID()
+		backup, err := s.BackupInstance(ctx, instanceID)
+		if err != nil {
+			log.Error().Err(err).Int("instanceID", instanceID).Msg("Scheduled torrent backup failed")
+			continue
+		}
+		log.Info().Int("instanceID", instanceID).Str("path", backup.Path).Int("torrents", backup.Torrents).Int("failed", len(backup.Failed)).Msg("Torrent backup completed")
+	}
+}
+
+// BackupInstance exports the .torrent file of every torrent on the instance into a new zip
+// archive and prunes archives beyond the retention. Torrents that fail to export are listed
+// in the result instead of failing the whole backup.
+func (s *TorrentBackupScheduler) BackupInstance(ctx context.Context, instanceID int) (*TorrentBackup, error) {
+	s.runMu.Lock()
+	defer s.runMu.Unlock()
+
+	dir, _, retention := s.config()
+	if dir == "" {
+		return nil, fmt.Errorf("backup directory is not configured")
+	}
+
+	client, err := s.syncManager.clientPool.GetClient(ctx, instanceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client: %w", err)
+	}
+
+	torrents, err := s.syncManager.getAllTorrentsForStats(ctx, instanceID, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get torrents: %w", err)
+	}
+
+	// The archives hold .torrent files with tracker passkeys, so only qui's user may read them
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create backup directory: %w", err)
+	}
+
+	now := time.Now()
+	path := filepath.Join(dir, backupFileName(instanceID, now))
+	tmpPath := path + ".tmp"
+
+	f, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create backup file: %w", err)
+	}
+	defer os.Remove(tmpPath)
+
+	backup := &TorrentBackup{InstanceID: instanceID, Path: path, CreatedAt: now}
+	zw := zip.NewWriter(f)
+
+	for _, torrent := range torrents {
+		if ctx.Err() != nil {
+			f.Close()
+			return nil, ctx.Err()
+		}
+
+		data, err := client.ExportTorrentCtx(ctx, torrent.Hash)
+		if err != nil {
+			log.Warn().Err(err).Int("instanceID", instanceID).Str("hash", torrent.Hash).Msg("Failed to export torrent for backup")
+			backup.Failed = append(backup.Failed, torrent.Hash)
+			continue
+		}
+
+		w, err := zw.Create(torrent.Hash + ".torrent")
+		if err == nil {
+			_, err = w.Write(data)
+		}
+		if err != nil {
+			f.Close()
+			return nil, fmt.Errorf("failed to write backup archive: %w", err)
+		}
+		backup.Torrents++
+	}
+
+	if err := zw.Close(); err != nil {
+		f.Close()
+		return nil, fmt.Errorf("failed to write backup archive: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return nil, fmt.Errorf("failed to write backup archive: %w", err)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return nil, fmt.Errorf("failed to finalize backup archive: %w", err)
+	}
+
+	if err := pruneBackups(dir, instanceID, retention); err != nil {
+		log.Warn().Err(err).Int("instanceID", instanceID).Msg("Failed to prune old torrent backups")
+	}
+
+	return backup, nil
+}
+
+func backupFilePrefix(instanceID int) string {
+	return fmt.Sprintf("instance-%d-", instanceID)
+}
+
+func backupFileName(instanceID int, at time.Time) string {
+	return backupFilePrefix(instanceID) + at.UTC().Format(backupTimeLayout) + ".zip"
+}
+
+// pruneBackups removes the oldest archives of an instance so at most retention remain.
+// The timestamp in the file name sorts chronologically.
+func pruneBackups(dir string, instanceID int, retention int) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	prefix := backupFilePrefix(instanceID)
+	var names []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, prefix) || !strings.HasSuffix(name, ".zip") {
+			continue
+		}
+		if _, err := time.Parse(backupTimeLayout, strings.TrimSuffix(strings.TrimPrefix(name, prefix), ".zip")); err != nil {
+			continue
+		}
+		names = append(names, name)
+	}
+
+	if len(names) <= retention {
+		return nil
+	}
+
+	slices.Sort(names)
+	for _, name := range names[:len(names)-retention] {
+		if err := os.Remove(filepath.Join(dir, name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
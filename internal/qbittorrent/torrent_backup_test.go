@@ -0,0 +1,48 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackupFileName(t *testing.T) {
+	at := time.Date(2025, 3, 4, 5, 6, 7, 0, time.UTC)
+	assert.Equal(t, "instance-12-20250304-050607.zip", backupFileName(12, at))
+}
+
+func TestPruneBackups(t *testing.T) {
+	dir := t.TempDir()
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for i := range 4 {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, backupFileName(1, base.Add(time.Duration(i)*time.Hour))), nil, 0644))
+	}
+	// Other instances and unrelated files are never touched
+	other := backupFileName(10, base)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, other), nil, 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "instance-1-notes.zip"), nil, 0644))
+
+	require.NoError(t, pruneBackups(dir, 1, 2))
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+
+	assert.ElementsMatch(t, []string{
+		backupFileName(1, base.Add(2*time.Hour)),
+		backupFileName(1, base.Add(3*time.Hour)),
+		other,
+		"instance-1-notes.zip",
+	}, names)
+}
@@ -0,0 +1,172 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"context"
+	"encoding/json"
+	"hash/fnv"
+	"slices"
+	"sync"
+	"time"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+)
+
+const (
+	// torrentChangeSnapshots is how many past sync snapshots are kept per instance to diff against
+	torrentChangeSnapshots = 16
+	// torrentChangeMaxAge is how old a cursor may be before a full snapshot is returned instead
+	torrentChangeMaxAge = 10 * time.Minute
+)
+
+// TorrentChanges is the difference between the torrents at a previous sync and now. When Full
+// is set the cursor could not be diffed and Torrents holds every torrent instead.
+type TorrentChanges struct {
+	SyncTime time.Time     `json:"syncTime"`
+	Full     bool          `json:"full"`
+	Torrents []qbt.Torrent `json:"torrents,omitempty"`
+	Added    []qbt.Torrent `json:"added,omitempty"`
+	Modified []qbt.Torrent `json:"modified,omitempty"`
+	Removed  []string      `json:"removed,omitempty"`
+}
+
+// torrentFingerprints maps each torrent hash to a hash of its contents at one sync
+type torrentFingerprints struct {
+	syncTime time.Time
+	takenAt  time.Time
+	torrents map[string]uint64
+}
+
+// torrentChangeTracker keeps recent fingerprint snapshots per instance, keyed by the sync time
+// handed out as cursor. Snapshots are only taken when changes are requested, so instances
+// nobody polls cost nothing.
+type torrentChangeTracker struct {
+	mu        sync.Mutex
+	snapshots map[int][]torrentFingerprints
+}
+
+func newTorrentChangeTracker() *torrentChangeTracker {
+	return &torrentChangeTracker{snapshots: make(map[int][]torrentFingerprints)}
+}
+
+// lookup returns the snapshot taken at syncTime, if it is still kept and recent enough to diff
+func (t *torrentChangeTracker) lookup(instanceID int, syncTime time.Time, now time.Time) (map[string]uint64, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, snapshot := range t.snapshots[instanceID] {
+		if snapshot.syncTime.Equal(syncTime) && now.Sub(snapshot.takenAt) < torrentChangeMaxAge {
+			return snapshot.torrents, true
+		}
+	}
+	return nil, false
+}
+
+// record stores the snapshot of a sync unless one is already kept for it, dropping the oldest
+// snapshots beyond the limit. Keeping the first snapshot of a sync means every client holding
+// that cursor diffs against the same state.
+func (t *torrentChangeTracker) record(instanceID int, snapshot torrentFingerprints) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	snapshots := t.snapshots[instanceID]
+	for _, existing := range snapshots {
+		if existing.syncTime.Equal(snapshot.syncTime) {
+			return
+		}
+	}
+
+	snapshots = append(snapshots, snapshot)
+	if len(snapshots) > torrentChangeSnapshots {
+		snapshots = slices.Delete(snapshots, 0, len(snapshots)-torrentChangeSnapshots)
+	}
+	t.snapshots[instanceID] = snapshots
+}
+
+// GetTorrentChangesSince returns the torrents added, modified and removed since the sync the
+// cursor refers to, along with the current sync time as the next cursor. A zero, unknown or
+// stale cursor returns a full snapshot.
+func (sm *SyncManager) GetTorrentChangesSince(ctx context.Context, instanceID int, since time.Time) (*TorrentChanges, error) {
+	_, syncManager, err := sm.getClientAndSyncManager(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	syncTime := syncManager.LastSyncTime()
+
+	torrents, err := sm.getAllTorrentsForStats(ctx, instanceID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	current := fingerprintTorrents(torrents)
+
+	var previous map[string]uint64
+	ok := false
+	if !since.IsZero() {
+		previous, ok = sm.torrentChanges.lookup(instanceID, since, now)
+	}
+	sm.torrentChanges.record(instanceID, torrentFingerprints{syncTime: syncTime, takenAt: now, torrents: current})
+
+	changes := &TorrentChanges{SyncTime: syncTime}
+	if !ok {
+		changes.Full = true
+		changes.Torrents = torrents
+		return changes, nil
+	}
+
+	added, modified, removed := diffTorrentFingerprints(previous, current)
+	for _, torrent := range torrents {
+		if _, isAdded := added[torrent.Hash]; isAdded {
+			changes.Added = append(changes.Added, torrent)
+		} else if _, isModified := modified[torrent.Hash]; isModified {
+			changes.Modified = append(changes.Modified, torrent)
+		}
+	}
+	changes.Removed = removed
+
+	return changes, nil
+}
+
+func fingerprintTorrents(torrents []qbt.Torrent) map[string]uint64 {
+	fingerprints := make(map[string]uint64, len(torrents))
+	for _, torrent := range torrents {
+		fingerprints[torrent.Hash] = fingerprintTorrent(torrent)
+	}
+	return fingerprints
+}
+
+func fingerprintTorrent(torrent qbt.Torrent) uint64 {
+	h := fnv.New64a()
+	// Marshalling a plain struct cannot fail; the encoding is stable for equal values
+	_ = json.NewEncoder(h).Encode(torrent)
+	return h.Sum64()
+}
+
+// diffTorrentFingerprints returns the hashes added and modified in current as sets, and the
+// sorted hashes removed since previous
+func diffTorrentFingerprints(previous, current map[string]uint64) (map[string]struct{}, map[string]struct{}, []string) {
+	added := make(map[string]struct{})
+	modified := make(map[string]struct{})
+	for hash, fingerprint := range current {
+		before, existed := previous[hash]
+		switch {
+		case !existed:
+			added[hash] = struct{}{}
+		case before != fingerprint:
+			modified[hash] = struct{}{}
+		}
+	}
+
+	var removed []string
+	for hash := range previous {
+		if _, exists := current[hash]; !exists {
+			removed = append(removed, hash)
+		}
+	}
+	slices.Sort(removed)
+
+	return added, modified, removed
+}
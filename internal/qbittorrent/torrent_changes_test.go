@@ -0,0 +1,56 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"testing"
+	"time"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffTorrentFingerprints(t *testing.T) {
+	previous := fingerprintTorrents([]qbt.Torrent{
+		{Hash: "a", Progress: 0.5},
+		{Hash: "b", Progress: 1},
+		{Hash: "c"},
+	})
+	current := fingerprintTorrents([]qbt.Torrent{
+		{Hash: "a", Progress: 0.75},
+		{Hash: "b", Progress: 1},
+		{Hash: "d"},
+	})
+
+	added, modified, removed := diffTorrentFingerprints(previous, current)
+	assert.Equal(t, map[string]struct{}{"d": {}}, added)
+	assert.Equal(t, map[string]struct{}{"a": {}}, modified)
+	assert.Equal(t, []string{"c"}, removed)
+}
+
+func TestTorrentChangeTracker(t *testing.T) {
+	tracker := newTorrentChangeTracker()
+	now := time.Now()
+	first := now.Add(-time.Minute)
+
+	tracker.record(1, torrentFingerprints{syncTime: first, takenAt: now, torrents: map[string]uint64{"a": 1}})
+	// A later snapshot of the same sync does not replace the first one
+	tracker.record(1, torrentFingerprints{syncTime: first, takenAt: now, torrents: map[string]uint64{"a": 2}})
+
+	snapshot, ok := tracker.lookup(1, first, now)
+	assert.True(t, ok)
+	assert.Equal(t, map[string]uint64{"a": 1}, snapshot)
+
+	_, ok = tracker.lookup(2, first, now)
+	assert.False(t, ok, "snapshots are per instance")
+
+	_, ok = tracker.lookup(1, first, now.Add(torrentChangeMaxAge))
+	assert.False(t, ok, "stale snapshots are not diffed")
+
+	for i := range torrentChangeSnapshots {
+		tracker.record(1, torrentFingerprints{syncTime: now.Add(time.Duration(i) * time.Second), takenAt: now})
+	}
+	_, ok = tracker.lookup(1, first, now)
+	assert.False(t, ok, "oldest snapshot is dropped beyond the limit")
+}
@@ -0,0 +1,258 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrTorrentCreatorUnsupported is returned when the instance's qBittorrent has no torrent creator API
+	ErrTorrentCreatorUnsupported = errors.New("torrent creation requires qBittorrent 5.0 or newer")
+	// ErrInvalidTorrentCreation is returned for invalid torrent creation options
+	ErrInvalidTorrentCreation = errors.New("invalid torrent creation options")
+	// ErrTorrentCreationTaskNotFound is returned for unknown torrent creation task IDs
+	ErrTorrentCreationTaskNotFound = errors.New("torrent creation task not found")
+	// ErrTorrentCreationNotFinished is returned when downloading the file of an unfinished task
+	ErrTorrentCreationNotFinished = errors.New("torrent creation task has not finished")
+)
+
+// TorrentFormats are the torrent formats qBittorrent can create; empty uses the client default
+var TorrentFormats = []string{"v1", "v2", "hybrid"}
+
+const (
+	minTorrentPieceSize = 16 << 10
+	maxTorrentPieceSize = 256 << 20
+)
+
+// TorrentCreateOptions are the metainfo and behaviour options of a new torrent
+type TorrentCreateOptions struct {
+	Format string `json:"format,omitempty"`
+	// PieceSize in bytes; zero lets qBittorrent pick one based on the content size
+	PieceSize int  `json:"pieceSize,omitempty"`
+	Private   bool `json:"private"`
+	// Trackers are announce URLs; an empty entry starts a new tier
+	Trackers []string `json:"trackers,omitempty"`
+	URLSeeds []string `json:"urlSeeds,omitempty"`
+	Comment  string   `json:"comment,omitempty"`
+	// Source is the source tag private trackers use to tell cross-seeded copies apart
+	Source string `json:"source,omitempty"`
+	// StartSeeding adds the created torrent to the instance and seeds it from the source path
+	StartSeeding bool `json:"startSeeding"`
+}
+
+// TorrentCreationTask is the status of a torrent creation job on qBittorrent
+type TorrentCreationTask struct {
+	TaskID       string  `json:"taskID"`
+	SourcePath   string  `json:"sourcePath"`
+	Status       string  `json:"status"` // Queued, Running, Finished or Failed
+	Progress     float64 `json:"progress"`
+	ErrorMessage string  `json:"errorMessage,omitempty"`
+	PieceSize    int     `json:"pieceSize"`
+	Private      bool    `json:"private"`
+	TimeAdded    string  `json:"timeAdded"`
+	TimeStarted  string  `json:"timeStarted,omitempty"`
+	TimeFinished string  `json:"timeFinished,omitempty"`
+}
+
+// CreateTorrentFile starts a torrent creation job for a file or directory on the instance's
+// filesystem and returns its task ID. Poll GetTorrentCreationTask for progress and fetch the
+// result with GetCreatedTorrentFile.
+func (sm *SyncManager) CreateTorrentFile(ctx context.Context, instanceID int, sourcePath string, options TorrentCreateOptions) (string, error) {
+	params, err := torrentCreatorParams(sourcePath, options)
+	if err != nil {
+		return "", err
+	}
+
+	client, err := sm.torrentCreatorClient(ctx, instanceID)
+	if err != nil {
+		return "", err
+	}
+
+	body, err := client.torrentCreatorRequestCtx(ctx, "addTask", params)
+	if err != nil {
+		return "", fmt.Errorf("failed to start torrent creation: %w", err)
+	}
+
+	var added struct {
+		TaskID string `json:"taskID"`
+	}
+	if err := json.Unmarshal(body, &added); err != nil {
+		return "", fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return added.TaskID, nil
+}
+
+// GetTorrentCreationTasks returns all torrent creation jobs on the instance
+func (sm *SyncManager) GetTorrentCreationTasks(ctx context.Context, instanceID int) ([]TorrentCreationTask, error) {
+	return sm.torrentCreationTasks(ctx, instanceID, nil)
+}
+
+// GetTorrentCreationTask returns the status of one torrent creation job
+func (sm *SyncManager) GetTorrentCreationTask(ctx context.Context, instanceID int, taskID string) (*TorrentCreationTask, error) {
+	tasks, err := sm.torrentCreationTasks(ctx, instanceID, url.Values{"taskID": {taskID}})
+	if err != nil {
+		return nil, err
+	}
+	if len(tasks) == 0 {
+		return nil, ErrTorrentCreationTaskNotFound
+	}
+	return &tasks[0], nil
+}
+
+func (sm *SyncManager) torrentCreationTasks(ctx context.Context, instanceID int, params url.Values) ([]TorrentCreationTask, error) {
+	client, err := sm.torrentCreatorClient(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := client.torrentCreatorRequestCtx(ctx, "status", params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get torrent creation status: %w", err)
+	}
+
+	var tasks []TorrentCreationTask
+	if err := json.Unmarshal(body, &tasks); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return tasks, nil
+}
+
+// GetCreatedTorrentFile returns the .torrent file of a finished creation job
+func (sm *SyncManager) GetCreatedTorrentFile(ctx context.Context, instanceID int, taskID string) ([]byte, error) {
+	client, err := sm.torrentCreatorClient(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := client.torrentCreatorRequestCtx(ctx, "torrentFile", url.Values{"taskID": {taskID}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get created torrent file: %w", err)
+	}
+
+	return data, nil
+}
+
+// DeleteTorrentCreationTask removes a creation job and its result from qBittorrent
+func (sm *SyncManager) DeleteTorrentCreationTask(ctx context.Context, instanceID int, taskID string) error {
+	client, err := sm.torrentCreatorClient(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.torrentCreatorRequestCtx(ctx, "deleteTask", url.Values{"taskID": {taskID}}); err != nil {
+		return fmt.Errorf("failed to delete torrent creation task: %w", err)
+	}
+
+	return nil
+}
+
+func (sm *SyncManager) torrentCreatorClient(ctx context.Context, instanceID int) (*Client, error) {
+	client, err := sm.clientPool.GetClient(ctx, instanceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client: %w", err)
+	}
+
+	if !client.Capabilities().TorrentCreator {
+		return nil, ErrTorrentCreatorUnsupported
+	}
+
+	return client, nil
+}
+
+// torrentCreatorParams validates the options and builds the torrentcreator/addTask form
+func torrentCreatorParams(sourcePath string, options TorrentCreateOptions) (url.Values, error) {
+	sourcePath = strings.TrimSpace(sourcePath)
+	if sourcePath == "" {
+		return nil, fmt.Errorf("%w: source path is required", ErrInvalidTorrentCreation)
+	}
+	if options.Format != "" && !slices.Contains(TorrentFormats, options.Format) {
+		return nil, fmt.Errorf("%w: format must be one of %v", ErrInvalidTorrentCreation, TorrentFormats)
+	}
+	if options.PieceSize != 0 {
+		size := options.PieceSize
+		if size < minTorrentPieceSize || size > maxTorrentPieceSize || size&(size-1) != 0 {
+			return nil, fmt.Errorf("%w: piece size must be a power of two between 16 KiB and 256 MiB", ErrInvalidTorrentCreation)
+		}
+	}
+
+	// Trim entries but keep empty ones, which separate tracker tiers
+	trackers := make([]string, len(options.Trackers))
+	for i, tracker := range options.Trackers {
+		trackers[i] = strings.TrimSpace(tracker)
+		if strings.Contains(trackers[i], "|") {
+			return nil, fmt.Errorf("%w: tracker URLs must not contain '|'", ErrInvalidTorrentCreation)
+		}
+	}
+
+	params := url.Values{
+		"sourcePath":   {sourcePath},
+		"private":      {strconv.FormatBool(options.Private)},
+		"startSeeding": {strconv.FormatBool(options.StartSeeding)},
+	}
+	if options.Format != "" {
+		params.Set("format", options.Format)
+	}
+	if options.PieceSize != 0 {
+		params.Set("pieceSize", strconv.Itoa(options.PieceSize))
+	}
+	if len(trackers) > 0 {
+		params.Set("trackers", strings.Join(trackers, "|"))
+	}
+	if len(options.URLSeeds) > 0 {
+		params.Set("urlSeeds", strings.Join(options.URLSeeds, "|"))
+	}
+	if options.Comment != "" {
+		params.Set("comment", options.Comment)
+	}
+	if options.Source != "" {
+		params.Set("source", options.Source)
+	}
+
+	return params, nil
+}
+
+// torrentCreatorRequestCtx calls a /api/v2/torrentcreator endpoint, which go-qbittorrent does
+// not wrap, and returns the response body
+func (c *Client) torrentCreatorRequestCtx(ctx context.Context, endpoint string, params url.Values) ([]byte, error) {
+	resp, err := c.doAPIRequestWithRelogin(ctx, "torrentcreator/"+endpoint, params)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, ErrTorrentCreationTaskNotFound
+	case http.StatusConflict:
+		if endpoint == "torrentFile" {
+			return nil, ErrTorrentCreationNotFinished
+		}
+		return nil, fmt.Errorf("torrent creation queue is full")
+	case http.StatusBadRequest:
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return nil, fmt.Errorf("%w: %s", ErrInvalidTorrentCreation, strings.TrimSpace(string(body)))
+	default:
+		return nil, unexpectedStatusError(resp)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	return body, nil
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTorrentCreatorParams(t *testing.T) {
+	params, err := torrentCreatorParams(" /data/linux-iso ", TorrentCreateOptions{
+		Format:    "hybrid",
+		PieceSize: 4 << 20,
+		Private:   true,
+		Trackers:  []string{"https://a.example/announce", "", " https://b.example/announce "},
+		Comment:   "release",
+		Source:    "EX",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "/data/linux-iso", params.Get("sourcePath"))
+	assert.Equal(t, "hybrid", params.Get("format"))
+	assert.Equal(t, "4194304", params.Get("pieceSize"))
+	assert.Equal(t, "true", params.Get("private"))
+	assert.Equal(t, "false", params.Get("startSeeding"))
+	assert.Equal(t, "https://a.example/announce||https://b.example/announce", params.Get("trackers"))
+	assert.Equal(t, "release", params.Get("comment"))
+	assert.Equal(t, "EX", params.Get("source"))
+	assert.False(t, params.Has("urlSeeds"))
+}
+
+func TestTorrentCreatorParamsValidation(t *testing.T) {
+	tests := []struct {
+		name       string
+		sourcePath string
+		options    TorrentCreateOptions
+	}{
+		{name: "missing source", sourcePath: " "},
+		{name: "unknown format", sourcePath: "/data", options: TorrentCreateOptions{Format: "v3"}},
+		{name: "piece size not a power of two", sourcePath: "/data", options: TorrentCreateOptions{PieceSize: 3 << 20}},
+		{name: "piece size too small", sourcePath: "/data", options: TorrentCreateOptions{PieceSize: 8 << 10}},
+		{name: "piece size too large", sourcePath: "/data", options: TorrentCreateOptions{PieceSize: 512 << 20}},
+		{name: "tracker with separator", sourcePath: "/data", options: TorrentCreateOptions{Trackers: []string{"https://a|b"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := torrentCreatorParams(tt.sourcePath, tt.options)
+			assert.ErrorIs(t, err, ErrInvalidTorrentCreation)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"time"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+)
+
+// TorrentDetails are the properties of a torrent with its origin lifted out, since the
+// comment, creator and private flag are not part of the torrent list
+type TorrentDetails struct {
+	qbt.TorrentProperties
+	Origin TorrentOrigin `json:"origin"`
+	Limits TorrentLimits `json:"limits"`
+}
+
+// TorrentOrigin describes where a torrent came from, as recorded in its metainfo
+type TorrentOrigin struct {
+	Comment   string `json:"comment"`
+	CreatedBy string `json:"createdBy"`
+	// CreatedAt is nil when the torrent has no creation date
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	Private   bool       `json:"private"`
+}
+
+func newTorrentDetails(props qbt.TorrentProperties) *TorrentDetails {
+	origin := TorrentOrigin{
+		Comment:   props.Comment,
+		CreatedBy: props.CreatedBy,
+		Private:   props.IsPrivate,
+	}
+	// qBittorrent reports -1 for torrents without a creation date
+	if props.CreationDate > 0 {
+		createdAt := time.Unix(int64(props.CreationDate), 0).UTC()
+		origin.CreatedAt = &createdAt
+	}
+
+	return &TorrentDetails{
+		TorrentProperties: props,
+		Origin:            origin,
+		Limits:            newTorrentLimits(int64(props.DlLimit), int64(props.UpLimit)),
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTorrentDetails(t *testing.T) {
+	details := newTorrentDetails(qbt.TorrentProperties{
+		Comment:      "https://tracker.example/torrents/1",
+		CreatedBy:    "mktorrent 1.1",
+		CreationDate: 1700000000,
+		IsPrivate:    true,
+	})
+
+	require.NotNil(t, details.Origin.CreatedAt)
+	assert.Equal(t, time.Unix(1700000000, 0).UTC(), *details.Origin.CreatedAt)
+	assert.Equal(t, "https://tracker.example/torrents/1", details.Origin.Comment)
+	assert.Equal(t, "mktorrent 1.1", details.Origin.CreatedBy)
+	assert.True(t, details.Origin.Private)
+
+	// The raw properties are still exposed alongside the origin
+	data, err := json.Marshal(details)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"is_private":true`)
+	assert.Contains(t, string(data), `"origin":{`)
+}
+
+func TestNewTorrentDetailsWithoutCreationDate(t *testing.T) {
+	details := newTorrentDetails(qbt.TorrentProperties{CreationDate: -1})
+	assert.Nil(t, details.Origin.CreatedAt)
+	assert.False(t, details.Origin.Private)
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"context"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+)
+
+// TorrentLimits are the speed limits set on a torrent, in KB/s like the set-limit actions.
+// 0 means the torrent is not limited in that direction.
+type TorrentLimits struct {
+	DownloadLimitKBs int64 `json:"downloadLimit"`
+	UploadLimitKBs   int64 `json:"uploadLimit"`
+	Limited          bool  `json:"limited"` // Either limit is set
+}
+
+// newTorrentLimits converts qBittorrent's per-torrent limits in bytes/s, which are 0 or -1 when
+// unlimited
+func newTorrentLimits(downloadBytes, uploadBytes int64) TorrentLimits {
+	return TorrentLimits{
+		DownloadLimitKBs: limitToKBs(downloadBytes),
+		UploadLimitKBs:   limitToKBs(uploadBytes),
+		Limited:          downloadBytes > 0 || uploadBytes > 0,
+	}
+}
+
+// limitToKBs converts a limit in bytes/s to KB/s, rounding up so a limit under 1 KB/s still
+// shows as a limit
+func limitToKBs(bytes int64) int64 {
+	if bytes <= 0 {
+		return 0
+	}
+	return (bytes + 1023) / 1024
+}
+
+// GetTorrentLimits returns the speed limits of torrents by hash. Torrents the instance doesn't
+// have are left out.
+func (sm *SyncManager) GetTorrentLimits(ctx context.Context, instanceID int, hashes []string) (map[string]TorrentLimits, error) {
+	_, syncManager, err := sm.getClientAndSyncManager(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The limits are part of the synced torrent list, so no request to qBittorrent is needed
+	torrents := syncManager.GetTorrents(qbt.TorrentFilterOptions{Hashes: hashes})
+
+	return torrentLimitsByHash(torrents), nil
+}
+
+func torrentLimitsByHash(torrents []qbt.Torrent) map[string]TorrentLimits {
+	limits := make(map[string]TorrentLimits, len(torrents))
+	for _, torrent := range torrents {
+		limits[torrent.Hash] = newTorrentLimits(torrent.DlLimit, torrent.UpLimit)
+	}
+	return limits
+}
@@ -0,0 +1,32 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"testing"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTorrentLimitsByHash(t *testing.T) {
+	limits := torrentLimitsByHash([]qbt.Torrent{
+		{Hash: "throttled", DlLimit: 2048 * 1024, UpLimit: 512 * 1024},
+		{Hash: "upload-only", DlLimit: -1, UpLimit: 100 * 1024},
+		{Hash: "unlimited", DlLimit: 0, UpLimit: -1},
+		{Hash: "sub-kib", DlLimit: 512, UpLimit: 1536},
+	})
+
+	assert.Equal(t, map[string]TorrentLimits{
+		"throttled":   {DownloadLimitKBs: 2048, UploadLimitKBs: 512, Limited: true},
+		"upload-only": {DownloadLimitKBs: 0, UploadLimitKBs: 100, Limited: true},
+		"unlimited":   {},
+		"sub-kib":     {DownloadLimitKBs: 1, UploadLimitKBs: 2, Limited: true},
+	}, limits)
+}
+
+func TestNewTorrentDetailsLimits(t *testing.T) {
+	details := newTorrentDetails(qbt.TorrentProperties{DlLimit: -1, UpLimit: 1024 * 1024})
+	assert.Equal(t, TorrentLimits{UploadLimitKBs: 1024, Limited: true}, details.Limits)
+}
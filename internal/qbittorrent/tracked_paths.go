@@ -0,0 +1,104 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"context"
+	"maps"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+)
+
+// TrackedPaths lists the paths torrents on an instance occupy, for reconciling against the
+// filesystem to find orphaned files. qui cannot see qBittorrent's filesystem, so the paths are
+// as qBittorrent reports them: they may be mounted elsewhere on the machine doing the diff,
+// files inside a torrent's content folder are not listed, and anything under a save path that
+// is not a content path is only a candidate orphan.
+type TrackedPaths struct {
+	InstanceID int                 `json:"instanceId"`
+	SavePaths  []TrackedSavePath   `json:"savePaths"`
+	Shared     []SharedContentPath `json:"shared"`
+}
+
+// TrackedSavePath is a save path and the content paths of the torrents saved in it
+type TrackedSavePath struct {
+	Path         string   `json:"path"`
+	TorrentCount int      `json:"torrentCount"`
+	ContentPaths []string `json:"contentPaths"`
+}
+
+// SharedContentPath is a content path used by more than one torrent. That is expected for
+// cross-seeds, but for unrelated torrents it means they overwrite each other's data.
+type SharedContentPath struct {
+	Path   string   `json:"path"`
+	Hashes []string `json:"hashes"`
+}
+
+// GetTrackedPaths returns the save and content paths of every torrent on the instance, and the
+// content paths more than one torrent uses
+func (sm *SyncManager) GetTrackedPaths(ctx context.Context, instanceID int) (*TrackedPaths, error) {
+	_, syncManager, err := sm.getClientAndSyncManager(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := collectTrackedPaths(syncManager.GetTorrents(qbt.TorrentFilterOptions{}))
+	paths.InstanceID = instanceID
+	return paths, nil
+}
+
+func collectTrackedPaths(torrents []qbt.Torrent) *TrackedPaths {
+	contentBySavePath := make(map[string]map[string]struct{})
+	torrentsBySavePath := make(map[string]int)
+	hashesByContentPath := make(map[string][]string)
+
+	for _, torrent := range torrents {
+		savePath := cleanTrackedPath(torrent.SavePath)
+		contentPath := cleanTrackedPath(torrent.ContentPath)
+
+		if savePath != "" {
+			torrentsBySavePath[savePath]++
+			if contentBySavePath[savePath] == nil {
+				contentBySavePath[savePath] = make(map[string]struct{})
+			}
+			if contentPath != "" {
+				contentBySavePath[savePath][contentPath] = struct{}{}
+			}
+		}
+		if contentPath != "" {
+			hashesByContentPath[contentPath] = append(hashesByContentPath[contentPath], torrent.Hash)
+		}
+	}
+
+	paths := &TrackedPaths{
+		SavePaths: make([]TrackedSavePath, 0, len(contentBySavePath)),
+		Shared:    []SharedContentPath{},
+	}
+	for _, savePath := range slices.Sorted(maps.Keys(contentBySavePath)) {
+		paths.SavePaths = append(paths.SavePaths, TrackedSavePath{
+			Path:         savePath,
+			TorrentCount: torrentsBySavePath[savePath],
+			ContentPaths: slices.Sorted(maps.Keys(contentBySavePath[savePath])),
+		})
+	}
+	for _, contentPath := range slices.Sorted(maps.Keys(hashesByContentPath)) {
+		if hashes := hashesByContentPath[contentPath]; len(hashes) > 1 {
+			slices.Sort(hashes)
+			paths.Shared = append(paths.Shared, SharedContentPath{Path: contentPath, Hashes: hashes})
+		}
+	}
+
+	return paths
+}
+
+// cleanTrackedPath normalizes a path reported by qBittorrent so equal paths compare equal
+func cleanTrackedPath(path string) string {
+	if strings.TrimSpace(path) == "" {
+		return ""
+	}
+	return filepath.Clean(path)
+}
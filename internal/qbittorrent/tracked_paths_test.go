@@ -0,0 +1,38 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"testing"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollectTrackedPaths(t *testing.T) {
+	torrents := []qbt.Torrent{
+		{Hash: "b", SavePath: "/data/movies/", ContentPath: "/data/movies/Movie.2024"},
+		{Hash: "a", SavePath: "/data/movies", ContentPath: "/data/movies/Movie.2024"},
+		{Hash: "c", SavePath: "/data/movies", ContentPath: "/data/movies/Other.mkv"},
+		{Hash: "d", SavePath: "/data/tv", ContentPath: "/data/tv/Show.S01"},
+		{Hash: "e", SavePath: "/data/tv"}, // Metadata not downloaded yet
+	}
+
+	paths := collectTrackedPaths(torrents)
+
+	require.Len(t, paths.SavePaths, 2)
+	assert.Equal(t, TrackedSavePath{
+		Path:         "/data/movies",
+		TorrentCount: 3,
+		ContentPaths: []string{"/data/movies/Movie.2024", "/data/movies/Other.mkv"},
+	}, paths.SavePaths[0])
+	assert.Equal(t, TrackedSavePath{
+		Path:         "/data/tv",
+		TorrentCount: 2,
+		ContentPaths: []string{"/data/tv/Show.S01"},
+	}, paths.SavePaths[1])
+
+	assert.Equal(t, []SharedContentPath{{Path: "/data/movies/Movie.2024", Hashes: []string{"a", "b"}}}, paths.Shared)
+}
@@ -0,0 +1,188 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// trackerListMaxBytes caps the size of a downloaded tracker list
+	trackerListMaxBytes = 1 << 20
+	// trackerListTimeout bounds how long downloading a tracker list may take
+	trackerListTimeout = 15 * time.Second
+)
+
+// ErrInvalidTrackerList is returned when a tracker list URL cannot be used or holds no trackers
+var ErrInvalidTrackerList = errors.New("invalid tracker list")
+
+var trackerListClient = &http.Client{Timeout: trackerListTimeout}
+
+// TrackerListResult is the outcome of adding the trackers of a tracker list
+type TrackerListResult struct {
+	Trackers       []string `json:"trackers"`       // Trackers in the list
+	SkippedPrivate []string `json:"skippedPrivate"` // Private torrents that were left alone
+}
+
+// BulkAddTrackersFromList downloads a newline-delimited tracker list, such as the public tracker
+// lists, and adds its trackers to the torrents. Lines that are not tracker URLs are skipped, and
+// each torrent only gets the trackers it does not have yet. Private torrents are skipped, since
+// public trackers would leak their peers and break tracker rules.
+func (sm *SyncManager) BulkAddTrackersFromList(ctx context.Context, instanceID int, hashes []string, listURL string) (*TrackerListResult, error) {
+	client, _, err := sm.getClientAndSyncManager(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := sm.validateTorrentsExist(client, hashes, "add trackers from list"); err != nil {
+		return nil, err
+	}
+
+	trackers, err := fetchTrackerList(ctx, listURL)
+	if err != nil {
+		return nil, err
+	}
+
+	public, private, err := client.splitPrivate(ctx, hashes)
+	if err != nil {
+		return nil, err
+	}
+	if len(private) > 0 {
+		log.Info().Int("instanceID", instanceID).Int("private", len(private)).Msg("Skipping private torrents when adding trackers from list")
+	}
+
+	result := &TrackerListResult{Trackers: trackers, SkippedPrivate: private}
+
+	var added bool
+	var lastErr error
+	for _, hash := range public {
+		existing, err := client.GetTorrentTrackersCtx(ctx, hash)
+		if err != nil {
+			log.Error().Err(err).Str("hash", hash).Msg("Failed to get torrent trackers")
+			lastErr = err
+			continue
+		}
+
+		missing := missingTrackers(trackers, existing)
+		if len(missing) == 0 {
+			continue
+		}
+
+		// addTrackers takes one URL per line
+		if err := client.AddTrackersCtx(ctx, hash, strings.Join(missing, "\n")); err != nil {
+			log.Error().Err(err).Str("hash", hash).Msg("Failed to add trackers to torrent")
+			lastErr = err
+			continue
+		}
+		added = true
+	}
+
+	if lastErr != nil && !added {
+		return nil, fmt.Errorf("failed to add trackers: %w", lastErr)
+	}
+
+	if added {
+		sm.syncAfterModification(instanceID, client, "bulk_add_trackers_from_list")
+	}
+
+	return result, nil
+}
+
+// missingTrackers returns the trackers a torrent does not have yet
+func missingTrackers(trackers []string, existing []qbt.TorrentTracker) []string {
+	have := make(map[string]struct{}, len(existing))
+	for _, tracker := range existing {
+		have[tracker.Url] = struct{}{}
+	}
+
+	var missing []string
+	for _, tracker := range trackers {
+		if _, ok := have[tracker]; !ok {
+			missing = append(missing, tracker)
+		}
+	}
+	return missing
+}
+
+// fetchTrackerList downloads a tracker list over http or https and returns its tracker URLs
+func fetchTrackerList(ctx context.Context, listURL string) ([]string, error) {
+	parsed, err := url.Parse(strings.TrimSpace(listURL))
+	if err != nil || parsed.Host == "" || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+		return nil, fmt.Errorf("%w: list URL must be an http or https URL", ErrInvalidTrackerList)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, parsed.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	resp, err := trackerListClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download tracker list: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: download returned status %d", ErrInvalidTrackerList, resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, trackerListMaxBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tracker list: %w", err)
+	}
+	if len(body) > trackerListMaxBytes {
+		return nil, fmt.Errorf("%w: list is larger than %d bytes", ErrInvalidTrackerList, trackerListMaxBytes)
+	}
+
+	trackers := parseTrackerList(string(body))
+	if len(trackers) == 0 {
+		return nil, fmt.Errorf("%w: no tracker URLs found", ErrInvalidTrackerList)
+	}
+
+	return trackers, nil
+}
+
+// parseTrackerList returns the unique tracker URLs of a newline-delimited list, skipping blank
+// lines, comments and anything that is not an http, https, udp or wss URL with a host
+func parseTrackerList(body string) []string {
+	seen := make(map[string]struct{})
+	var trackers []string
+
+	scanner := bufio.NewScanner(strings.NewReader(body))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		parsed, err := url.Parse(line)
+		if err != nil || parsed.Host == "" {
+			continue
+		}
+		switch parsed.Scheme {
+		case "http", "https", "udp", "wss":
+		default:
+			continue
+		}
+
+		if _, ok := seen[line]; ok {
+			continue
+		}
+		seen[line] = struct{}{}
+		trackers = append(trackers, line)
+	}
+
+	return trackers
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	qbt "github.com/autobrr/go-qbittorrent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTrackerList(t *testing.T) {
+	body := "udp://tracker.one:1337/announce\n\n# comment\r\nhttps://tracker.two/announce\nnot a url\nftp://tracker.three/announce\nudp://tracker.one:1337/announce\n"
+
+	assert.Equal(t, []string{"udp://tracker.one:1337/announce", "https://tracker.two/announce"}, parseTrackerList(body))
+}
+
+func TestMissingTrackers(t *testing.T) {
+	existing := []qbt.TorrentTracker{{Url: "** [DHT] **"}, {Url: "udp://tracker.one:1337/announce"}}
+
+	missing := missingTrackers([]string{"udp://tracker.one:1337/announce", "https://tracker.two/announce"}, existing)
+	assert.Equal(t, []string{"https://tracker.two/announce"}, missing)
+}
+
+func TestFetchTrackerList(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/list":
+			w.Write([]byte("udp://tracker.one:1337/announce\n"))
+		case "/large":
+			w.Write([]byte(strings.Repeat("#", trackerListMaxBytes+1)))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	trackers, err := fetchTrackerList(context.Background(), server.URL+"/list")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"udp://tracker.one:1337/announce"}, trackers)
+
+	_, err = fetchTrackerList(context.Background(), server.URL+"/large")
+	assert.ErrorIs(t, err, ErrInvalidTrackerList)
+
+	_, err = fetchTrackerList(context.Background(), server.URL+"/missing")
+	assert.ErrorIs(t, err, ErrInvalidTrackerList)
+
+	_, err = fetchTrackerList(context.Background(), "file:///etc/passwd")
+	assert.ErrorIs(t, err, ErrInvalidTrackerList)
+}
+
+func TestApplyPerTorrent(t *testing.T) {
+	failing := map[string]bool{"b": true}
+	apply := func(hash string) error {
+		if failing[hash] {
+			return errors.New("torrent is in error state")
+		}
+		return nil
+	}
+
+	results, succeeded, err := applyPerTorrent(context.Background(), []string{"a", "b", "c"}, "edit trackers", nil, apply)
+	require.NoError(t, err, "a partial failure is not an error")
+	assert.Equal(t, []string{"a", "c"}, succeeded)
+	assert.Equal(t, []TrackerResult{
+		{Hash: "a", Success: true},
+		{Hash: "b", Error: "torrent is in error state"},
+		{Hash: "c", Success: true},
+	}, results)
+
+	results, succeeded, err = applyPerTorrent(context.Background(), []string{"b"}, "edit trackers", nil, apply)
+	assert.EqualError(t, err, "failed to edit trackers: torrent is in error state")
+	assert.Empty(t, succeeded)
+	assert.Len(t, results, 1)
+}
+
+func TestApplyPerTorrentProgressAndCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var processed, failures int
+	progress := func(failed bool) {
+		processed++
+		if failed {
+			failures++
+		}
+	}
+	apply := func(hash string) error {
+		if hash == "b" {
+			cancel()
+			return errors.New("tracker rejected")
+		}
+		return nil
+	}
+
+	results, succeeded, err := applyPerTorrent(ctx, []string{"a", "b", "c"}, "edit trackers", progress, apply)
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, []string{"a"}, succeeded, "hashes after the cancellation are not processed")
+	assert.Len(t, results, 2)
+	assert.Equal(t, 2, processed)
+	assert.Equal(t, 1, failures)
+}
@@ -0,0 +1,285 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+var (
+	// ErrInvalidTrackerTiers is returned for a tracker tier layout that can't be applied
+	ErrInvalidTrackerTiers = errors.New("invalid tracker tiers")
+	// ErrTrackerTiersUnsupported is returned when trackers have to be added above tier 0 on a
+	// qBittorrent that adds every tracker to tier 0
+	ErrTrackerTiersUnsupported = errors.New("adding trackers above tier 0 requires qBittorrent 5.0 or newer")
+)
+
+// trackerTierEntry is a tracker URL and the tier qBittorrent reports for it
+type trackerTierEntry struct {
+	URL  string
+	Tier int
+}
+
+// rawTrackerTier is a tracker of a torrents/trackers response. The tier is a number, or an empty
+// string for DHT, PeX and LSD.
+type rawTrackerTier struct {
+	URL  string          `json:"url"`
+	Tier json.RawMessage `json:"tier"`
+}
+
+// trackerTierPlan is what has to change to turn the current tiers of a torrent into the desired ones
+type trackerTierPlan struct {
+	Remove []string   // Trackers that are dropped or move to another tier
+	Add    [][]string // Trackers to add, indexed by tier
+}
+
+// Empty reports whether the plan leaves the trackers as they are
+func (p trackerTierPlan) Empty() bool {
+	return len(p.Remove) == 0 && len(p.Add) == 0
+}
+
+// GetTrackerTiers returns the trackers of a torrent indexed by tier. DHT, PeX and LSD are not
+// trackers and are left out.
+func (sm *SyncManager) GetTrackerTiers(ctx context.Context, instanceID int, hash string) ([][]string, error) {
+	client, _, err := sm.getClientAndSyncManager(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := client.getTrackerTiersCtx(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return groupTrackerTiers(entries), nil
+}
+
+// SetTrackerTiers reorganizes the trackers of a torrent into the given tiers. Trackers already in
+// their tier are left alone so their announce state is kept; trackers that are dropped or change
+// tier are removed, and the rest are added to their tier. If adding fails, the removed trackers
+// are added back at their original tiers. Adding trackers above tier 0 needs FeatureTrackerTiers.
+func (sm *SyncManager) SetTrackerTiers(ctx context.Context, instanceID int, hash string, tiers [][]string) error {
+	tiers, err := normalizeTrackerTiers(tiers)
+	if err != nil {
+		return err
+	}
+
+	client, _, err := sm.getClientAndSyncManager(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+
+	if err := sm.validateTorrentsExist(client, []string{hash}, "set tracker tiers"); err != nil {
+		return err
+	}
+
+	current, err := client.getTrackerTiersCtx(ctx, hash)
+	if err != nil {
+		return err
+	}
+
+	plan := planTrackerTiers(current, tiers)
+	if plan.Empty() {
+		return nil
+	}
+	// Older versions ignore the blank lines between tiers and would put everything in tier 0
+	if len(plan.Add) > 1 && !client.Capabilities().TrackerTiers {
+		return ErrTrackerTiersUnsupported
+	}
+
+	// Remove first, qBittorrent ignores adding a URL the torrent already has
+	if len(plan.Remove) > 0 {
+		if err := client.RemoveTrackersCtx(ctx, hash, strings.Join(plan.Remove, "|")); err != nil {
+			return fmt.Errorf("failed to remove trackers: %w", err)
+		}
+	}
+	if len(plan.Add) > 0 {
+		if err := client.AddTrackersCtx(ctx, hash, formatTrackerTiers(plan.Add)); err != nil {
+			err = fmt.Errorf("failed to add trackers: %w", err)
+			// Put the removed trackers back where they were so the torrent isn't left without them
+			if len(plan.Remove) > 0 {
+				restore := formatTrackerTiers(removedTrackerTiers(current, plan.Remove))
+				if restoreErr := client.AddTrackersCtx(ctx, hash, restore); restoreErr != nil {
+					err = errors.Join(err, fmt.Errorf("failed to restore removed trackers: %w", restoreErr))
+				}
+			}
+			sm.syncAfterModification(instanceID, client, "set_tracker_tiers")
+			return err
+		}
+	}
+
+	sm.syncAfterModification(instanceID, client, "set_tracker_tiers")
+
+	return nil
+}
+
+// normalizeTrackerTiers trims the URLs of a tier layout and checks that it has trackers, every
+// URL is an http, https, udp or wss tracker and no URL is listed twice. Empty tiers are kept so a
+// GetTrackerTiers result keeps its tier numbers; trailing ones are dropped.
+func normalizeTrackerTiers(tiers [][]string) ([][]string, error) {
+	for len(tiers) > 0 && len(tiers[len(tiers)-1]) == 0 {
+		tiers = tiers[:len(tiers)-1]
+	}
+	if len(tiers) == 0 {
+		return nil, fmt.Errorf("%w: at least one tracker is required", ErrInvalidTrackerTiers)
+	}
+
+	seen := make(map[string]struct{})
+	normalized := make([][]string, 0, len(tiers))
+	for _, tier := range tiers {
+		urls := make([]string, 0, len(tier))
+		for _, raw := range tier {
+			trackerURL := strings.TrimSpace(raw)
+			parsed, err := url.Parse(trackerURL)
+			if err != nil || parsed.Host == "" {
+				return nil, fmt.Errorf("%w: %q is not a tracker URL", ErrInvalidTrackerTiers, raw)
+			}
+			switch parsed.Scheme {
+			case "http", "https", "udp", "wss":
+			default:
+				return nil, fmt.Errorf("%w: %q must be an http, https, udp or wss URL", ErrInvalidTrackerTiers, raw)
+			}
+
+			if _, ok := seen[trackerURL]; ok {
+				return nil, fmt.Errorf("%w: %q is listed more than once", ErrInvalidTrackerTiers, trackerURL)
+			}
+			seen[trackerURL] = struct{}{}
+			urls = append(urls, trackerURL)
+		}
+		normalized = append(normalized, urls)
+	}
+
+	return normalized, nil
+}
+
+// groupTrackerTiers groups trackers by tier, indexed by tier number. Tiers without trackers are
+// left empty so tier numbers are kept and the result can be passed back to SetTrackerTiers as is.
+func groupTrackerTiers(entries []trackerTierEntry) [][]string {
+	tiers := [][]string{}
+	for _, entry := range entries {
+		for len(tiers) <= entry.Tier {
+			tiers = append(tiers, []string{})
+		}
+		tiers[entry.Tier] = append(tiers[entry.Tier], entry.URL)
+	}
+
+	return tiers
+}
+
+// planTrackerTiers works out which trackers to remove and add so the torrent ends up with the
+// desired tiers, where desired[i] is tier i
+func planTrackerTiers(current []trackerTierEntry, desired [][]string) trackerTierPlan {
+	currentTier := make(map[string]int, len(current))
+	for _, entry := range current {
+		currentTier[entry.URL] = entry.Tier
+	}
+
+	wanted := make(map[string]int)
+	var plan trackerTierPlan
+	for tier, urls := range desired {
+		for _, trackerURL := range urls {
+			wanted[trackerURL] = tier
+			if existing, ok := currentTier[trackerURL]; ok && existing == tier {
+				continue
+			}
+			for len(plan.Add) <= tier {
+				plan.Add = append(plan.Add, nil)
+			}
+			plan.Add[tier] = append(plan.Add[tier], trackerURL)
+		}
+	}
+
+	for _, entry := range current {
+		if tier, ok := wanted[entry.URL]; !ok || tier != entry.Tier {
+			plan.Remove = append(plan.Remove, entry.URL)
+		}
+	}
+
+	return plan
+}
+
+// removedTrackerTiers groups removed trackers by the tier they had, indexed by tier, so they can
+// be added back where they were
+func removedTrackerTiers(current []trackerTierEntry, removed []string) [][]string {
+	isRemoved := make(map[string]struct{}, len(removed))
+	for _, trackerURL := range removed {
+		isRemoved[trackerURL] = struct{}{}
+	}
+
+	var tiers [][]string
+	for _, entry := range current {
+		if _, ok := isRemoved[entry.URL]; !ok {
+			continue
+		}
+		for len(tiers) <= entry.Tier {
+			tiers = append(tiers, nil)
+		}
+		tiers[entry.Tier] = append(tiers[entry.Tier], entry.URL)
+	}
+	return tiers
+}
+
+// formatTrackerTiers formats tiers for torrents/addTrackers, which starts at tier 0 and moves to
+// the next tier on every blank line
+func formatTrackerTiers(tiers [][]string) string {
+	lines := make([]string, 0)
+	for i, tier := range tiers {
+		if i > 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, tier...)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// getTrackerTiersCtx fetches the trackers of a torrent with their tiers. go-qbittorrent drops
+// the tier since qBittorrent reports it as an empty string for DHT, PeX and LSD, so the
+// endpoint is called directly.
+func (c *Client) getTrackerTiersCtx(ctx context.Context, hash string) ([]trackerTierEntry, error) {
+	params := url.Values{"hash": {hash}}
+
+	resp, err := c.doAPIRequestWithRelogin(ctx, "torrents/trackers", params)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("torrent not found: %s", hash)
+	default:
+		return nil, unexpectedStatusError(resp)
+	}
+
+	var raw []rawTrackerTier
+	if err := json.NewDecoder(resp.Body).Decode(&raw); err != nil {
+		return nil, fmt.Errorf("failed to decode trackers: %w", err)
+	}
+
+	return parseTrackerTierEntries(raw), nil
+}
+
+// parseTrackerTierEntries keeps the trackers of a torrents/trackers response that have a numeric
+// tier, which leaves out the "** [DHT] **" style pseudo-trackers
+func parseTrackerTierEntries(raw []rawTrackerTier) []trackerTierEntry {
+	entries := make([]trackerTierEntry, 0, len(raw))
+	for _, tracker := range raw {
+		if strings.HasPrefix(tracker.URL, "** [") {
+			continue
+		}
+		var tier int
+		if err := json.Unmarshal(tracker.Tier, &tier); err != nil || tier < 0 {
+			continue
+		}
+		entries = append(entries, trackerTierEntry{URL: tracker.URL, Tier: tier})
+	}
+	return entries
+}
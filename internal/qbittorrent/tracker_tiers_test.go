@@ -0,0 +1,101 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTrackerTierEntries(t *testing.T) {
+	var raw []rawTrackerTier
+	require.NoError(t, json.Unmarshal([]byte(`[
+		{"url": "** [DHT] **", "tier": ""},
+		{"url": "** [PeX] **", "tier": -1},
+		{"url": "https://primary.example.com/announce", "tier": 0},
+		{"url": "udp://backup.example.com:6969/announce", "tier": 2}
+	]`), &raw))
+
+	entries := parseTrackerTierEntries(raw)
+
+	assert.Equal(t, []trackerTierEntry{
+		{URL: "https://primary.example.com/announce", Tier: 0},
+		{URL: "udp://backup.example.com:6969/announce", Tier: 2},
+	}, entries)
+	tiers := groupTrackerTiers(entries)
+	assert.Equal(t, [][]string{
+		{"https://primary.example.com/announce"},
+		{},
+		{"udp://backup.example.com:6969/announce"},
+	}, tiers, "tier numbers are kept")
+
+	// Passing the tiers back unchanged leaves every tracker where it is
+	normalized, err := normalizeTrackerTiers(tiers)
+	require.NoError(t, err)
+	assert.True(t, planTrackerTiers(entries, normalized).Empty())
+}
+
+func TestNormalizeTrackerTiers(t *testing.T) {
+	tiers, err := normalizeTrackerTiers([][]string{{" https://a.example.com/announce "}, {"udp://b.example.com:6969/announce"}})
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{{"https://a.example.com/announce"}, {"udp://b.example.com:6969/announce"}}, tiers)
+
+	tiers, err = normalizeTrackerTiers([][]string{{}, {"https://a.example.com/announce"}, {}})
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{{}, {"https://a.example.com/announce"}}, tiers, "empty tiers keep tier numbers, trailing ones are dropped")
+
+	for name, invalid := range map[string][][]string{
+		"no tiers":    {},
+		"no trackers": {{}, {}},
+		"scheme":      {{"ftp://a.example.com/announce"}},
+		"no host":     {{"not a url"}},
+		"duplicate":   {{"https://a.example.com/announce"}, {"https://a.example.com/announce"}},
+	} {
+		_, err := normalizeTrackerTiers(invalid)
+		assert.ErrorIs(t, err, ErrInvalidTrackerTiers, name)
+	}
+}
+
+func TestPlanTrackerTiers(t *testing.T) {
+	current := []trackerTierEntry{
+		{URL: "https://a.example.com/announce", Tier: 0},
+		{URL: "https://b.example.com/announce", Tier: 0},
+		{URL: "https://c.example.com/announce", Tier: 1},
+	}
+
+	t.Run("unchanged", func(t *testing.T) {
+		plan := planTrackerTiers(current, [][]string{
+			{"https://a.example.com/announce", "https://b.example.com/announce"},
+			{"https://c.example.com/announce"},
+		})
+		assert.True(t, plan.Empty())
+	})
+
+	t.Run("reorganize", func(t *testing.T) {
+		plan := planTrackerTiers(current, [][]string{
+			{"https://a.example.com/announce"},
+			{"https://c.example.com/announce", "https://b.example.com/announce"},
+			{"https://d.example.com/announce"},
+		})
+
+		// a and c stay where they are; b moves down a tier and d is new
+		assert.Equal(t, []string{"https://b.example.com/announce"}, plan.Remove)
+		assert.Equal(t, [][]string{nil, {"https://b.example.com/announce"}, {"https://d.example.com/announce"}}, plan.Add)
+		assert.Equal(t, "\nhttps://b.example.com/announce\n\nhttps://d.example.com/announce", formatTrackerTiers(plan.Add))
+
+		// If adding fails, b goes back to the tier it was removed from
+		assert.Equal(t, [][]string{{"https://b.example.com/announce"}}, removedTrackerTiers(current, plan.Remove))
+	})
+
+	t.Run("drop", func(t *testing.T) {
+		plan := planTrackerTiers(current, [][]string{{"https://a.example.com/announce"}})
+		assert.Equal(t, []string{"https://b.example.com/announce", "https://c.example.com/announce"}, plan.Remove)
+		assert.Empty(t, plan.Add)
+		assert.Equal(t, "https://b.example.com/announce\n\nhttps://c.example.com/announce",
+			formatTrackerTiers(removedTrackerTiers(current, plan.Remove)))
+	})
+}
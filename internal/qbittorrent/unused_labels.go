@@ -0,0 +1,115 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// UnusedLabels are the tags and categories no torrent on an instance uses
+type UnusedLabels struct {
+	Tags       []string `json:"tags"`
+	Categories []string `json:"categories"`
+	// DryRun is set when the labels were only listed, not removed
+	DryRun bool `json:"dryRun"`
+}
+
+// GetUnusedTags returns the tags no torrent uses
+func (sm *SyncManager) GetUnusedTags(ctx context.Context, instanceID int) ([]string, error) {
+	labels, err := sm.getUnusedLabels(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	return labels.Tags, nil
+}
+
+// GetUnusedCategories returns the categories no torrent uses
+func (sm *SyncManager) GetUnusedCategories(ctx context.Context, instanceID int) ([]string, error) {
+	labels, err := sm.getUnusedLabels(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	return labels.Categories, nil
+}
+
+// PruneUnused deletes the tags and categories no torrent uses and returns what was removed.
+// With dryRun set nothing is deleted and the labels that would be removed are returned.
+func (sm *SyncManager) PruneUnused(ctx context.Context, instanceID int, dryRun bool) (*UnusedLabels, error) {
+	labels, err := sm.getUnusedLabels(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	labels.DryRun = dryRun
+	if dryRun {
+		return labels, nil
+	}
+
+	if len(labels.Tags) > 0 {
+		if err := sm.DeleteTags(ctx, instanceID, labels.Tags); err != nil {
+			return nil, fmt.Errorf("failed to delete unused tags: %w", err)
+		}
+	}
+	if len(labels.Categories) > 0 {
+		if err := sm.RemoveCategories(ctx, instanceID, labels.Categories); err != nil {
+			return nil, fmt.Errorf("failed to remove unused categories: %w", err)
+		}
+	}
+
+	return labels, nil
+}
+
+func (sm *SyncManager) getUnusedLabels(ctx context.Context, instanceID int) (*UnusedLabels, error) {
+	_, syncManager, err := sm.getClientAndSyncManager(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := sm.GetTorrentCounts(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	categories := slices.Collect(maps.Keys(syncManager.GetCategories()))
+	return &UnusedLabels{
+		Tags:       unusedLabels(syncManager.GetTags(), counts.Tags),
+		Categories: unusedCategories(categories, counts.Categories),
+	}, nil
+}
+
+// unusedLabels returns the labels without a torrent count, sorted case-insensitively. The empty
+// label counts untagged or uncategorized torrents and is never reported.
+func unusedLabels(labels []string, counts map[string]int) []string {
+	unused := []string{}
+	for _, label := range labels {
+		if label != "" && counts[label] == 0 {
+			unused = append(unused, label)
+		}
+	}
+
+	slices.SortFunc(unused, func(a, b string) int {
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	})
+	return unused
+}
+
+// unusedCategories is unusedLabels for categories. A parent category used only through its
+// subcategories ("movies" via "movies/hd") is in use: removing it with subcategories enabled
+// would also remove the subcategories and clear them from their torrents.
+func unusedCategories(categories []string, counts map[string]int) []string {
+	unused := unusedLabels(categories, counts)
+	return slices.DeleteFunc(unused, func(category string) bool {
+		prefix := category + "/"
+		for used, count := range counts {
+			if count > 0 && strings.HasPrefix(used, prefix) {
+				return true
+			}
+		}
+		return false
+	})
+}
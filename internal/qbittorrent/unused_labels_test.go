@@ -0,0 +1,33 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnusedLabels(t *testing.T) {
+	counts := map[string]int{"": 4, "movies": 2, "tv": 0}
+
+	unused := unusedLabels([]string{"tv", "movies", "Old", "archive", ""}, counts)
+
+	assert.Equal(t, []string{"archive", "Old", "tv"}, unused)
+}
+
+func TestUnusedLabelsNoneUnused(t *testing.T) {
+	unused := unusedLabels([]string{"movies"}, map[string]int{"movies": 1})
+
+	assert.NotNil(t, unused)
+	assert.Empty(t, unused)
+}
+
+func TestUnusedCategoriesKeepsParentsOfUsedSubcategories(t *testing.T) {
+	counts := map[string]int{"movies/hd": 3, "tv/old": 0, "music": 1}
+
+	unused := unusedCategories([]string{"movies", "movies/hd", "movies-archive", "tv", "tv/old", "music"}, counts)
+
+	assert.Equal(t, []string{"movies-archive", "tv", "tv/old"}, unused)
+}
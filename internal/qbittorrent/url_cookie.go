@@ -0,0 +1,34 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+)
+
+// ErrInvalidCookie is returned for cookies that are not a valid Cookie header. The cookie itself
+// is never part of the error, since it usually holds a session token.
+var ErrInvalidCookie = errors.New("invalid cookie: expected name=value pairs separated by semicolons")
+
+// normalizeTorrentCookie validates a cookie sent along with URL adds, e.g. "uid=1; pass=abc",
+// and returns it in canonical form
+func normalizeTorrentCookie(cookie string) (string, error) {
+	cookie = strings.TrimSpace(cookie)
+	if cookie == "" || strings.ContainsAny(cookie, "\r\n") {
+		return "", ErrInvalidCookie
+	}
+
+	cookies, err := http.ParseCookie(cookie)
+	if err != nil {
+		return "", ErrInvalidCookie
+	}
+
+	pairs := make([]string, len(cookies))
+	for i, c := range cookies {
+		pairs[i] = c.String()
+	}
+	return strings.Join(pairs, "; "), nil
+}
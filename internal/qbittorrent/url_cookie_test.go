@@ -0,0 +1,32 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package qbittorrent
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeTorrentCookie(t *testing.T) {
+	cookie, err := normalizeTorrentCookie("  uid=123;pass=abc  ")
+	require.NoError(t, err)
+	assert.Equal(t, "uid=123; pass=abc", cookie)
+
+	cookie, err = normalizeTorrentCookie("session=a1b2c3")
+	require.NoError(t, err)
+	assert.Equal(t, "session=a1b2c3", cookie)
+
+	for _, invalid := range []string{"", "   ", "novalue", "uid=1\r\nX-Injected: yes", "=value"} {
+		_, err := normalizeTorrentCookie(invalid)
+		assert.ErrorIs(t, err, ErrInvalidCookie, "cookie %q", invalid)
+	}
+}
+
+func TestInvalidCookieErrorOmitsCookie(t *testing.T) {
+	_, err := normalizeTorrentCookie("secret-token")
+	require.Error(t, err)
+	assert.NotContains(t, err.Error(), "secret-token")
+}
@@ -0,0 +1,173 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package license
+
+import (
+	"context"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// DefaultRefreshInterval is how often licenses are refreshed when not configured
+	DefaultRefreshInterval = 6 * time.Hour
+
+	refreshRetryBase   = time.Minute
+	refreshJitterRatio = 0.2
+)
+
+type Refresher interface {
+	RefreshAllLicenses(ctx context.Context) error
+}
+
+// RefreshSchedule describes the state of the background license refresh
+type RefreshSchedule struct {
+	Interval            string     `json:"interval"`
+	NextRefresh         time.Time  `json:"nextRefresh"`
+	LastRefresh         *time.Time `json:"lastRefresh,omitempty"`
+	LastError           string     `json:"lastError,omitempty"`
+	ConsecutiveFailures int        `json:"consecutiveFailures"`
+}
+
+// RefreshScheduler periodically refreshes all licenses. Licenses without an activation ID are
+// re-activated by RefreshAllLicenses; when Polar is unreachable the next attempt is retried with
+// jittered exponential backoff, capped at the refresh interval.
+type RefreshScheduler struct {
+	service Refresher
+
+	mu          sync.RWMutex
+	interval    time.Duration
+	nextRefresh time.Time
+	lastRefresh time.Time
+	lastError   error
+	failures    int
+	reset       chan struct{}
+}
+
+func NewRefreshScheduler(service Refresher, interval time.Duration) *RefreshScheduler {
+	if interval <= 0 {
+		interval = DefaultRefreshInterval
+	}
+	return &RefreshScheduler{
+		service:  service,
+		interval: interval,
+		reset:    make(chan struct{}, 1),
+	}
+}
+
+// Start runs the scheduler until ctx is cancelled. The first refresh runs immediately.
+func (s *RefreshScheduler) Start(ctx context.Context) {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.reset:
+			if !timer.Stop() {
+				select {
+				case <-timer.C:
+				default:
+				}
+			}
+			timer.Reset(time.Until(s.NextRefresh()))
+		case <-timer.C:
+			timer.Reset(s.refresh(ctx))
+		}
+	}
+}
+
+// SetInterval changes the refresh cadence and reschedules the next refresh
+func (s *RefreshScheduler) SetInterval(interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultRefreshInterval
+	}
+
+	s.mu.Lock()
+	if interval == s.interval {
+		s.mu.Unlock()
+		return
+	}
+	s.interval = interval
+	// Failures keep their backoff; only a healthy schedule moves to the new cadence
+	if s.failures == 0 && !s.lastRefresh.IsZero() {
+		s.nextRefresh = s.lastRefresh.Add(interval)
+	}
+	s.mu.Unlock()
+
+	select {
+	case s.reset <- struct{}{}:
+	default:
+	}
+}
+
+// NextRefresh returns when the next refresh is scheduled
+func (s *RefreshScheduler) NextRefresh() time.Time {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.nextRefresh
+}
+
+// Status returns the current schedule
+func (s *RefreshScheduler) Status() RefreshSchedule {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	status := RefreshSchedule{
+		Interval:            s.interval.String(),
+		NextRefresh:         s.nextRefresh,
+		ConsecutiveFailures: s.failures,
+	}
+	if !s.lastRefresh.IsZero() {
+		lastRefresh := s.lastRefresh
+		status.LastRefresh = &lastRefresh
+	}
+	if s.lastError != nil {
+		status.LastError = s.lastError.Error()
+	}
+	return status
+}
+
+// refresh refreshes all licenses and returns the delay until the next attempt
+func (s *RefreshScheduler) refresh(ctx context.Context) time.Duration {
+	err := s.service.RefreshAllLicenses(ctx)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.lastRefresh = time.Now()
+	s.lastError = err
+
+	var delay time.Duration
+	if err != nil {
+		s.failures++
+		delay = refreshBackoff(s.failures, s.interval, rand.Float64())
+		log.Warn().Err(err).Int("failures", s.failures).Dur("retryIn", delay).Msg("License refresh failed, retrying with backoff")
+	} else {
+		s.failures = 0
+		delay = s.interval
+		log.Debug().Dur("nextIn", delay).Msg("License refresh completed")
+	}
+
+	s.nextRefresh = s.lastRefresh.Add(delay)
+	return delay
+}
+
+// refreshBackoff returns the retry delay after the given number of consecutive failures.
+// The delay doubles from refreshRetryBase up to maxDelay, then is jittered by ±20% using
+// jitter in [0,1) so many instances don't retry against Polar at the same moment.
+func refreshBackoff(failures int, maxDelay time.Duration, jitter float64) time.Duration {
+	delay := refreshRetryBase
+	for i := 1; i < failures && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	delay = min(delay, maxDelay)
+
+	factor := 1 + refreshJitterRatio*(2*jitter-1)
+	return time.Duration(float64(delay) * factor)
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2025, s0up and the autobrr contributors.
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+package license
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeRefresher struct {
+	err error
+}
+
+func (f *fakeRefresher) RefreshAllLicenses(context.Context) error {
+	return f.err
+}
+
+func TestRefreshBackoff(t *testing.T) {
+	tests := []struct {
+		name     string
+		failures int
+		jitter   float64
+		expected time.Duration
+	}{
+		{name: "first failure", failures: 1, jitter: 0.5, expected: time.Minute},
+		{name: "doubles per failure", failures: 3, jitter: 0.5, expected: 4 * time.Minute},
+		{name: "capped at interval", failures: 20, jitter: 0.5, expected: time.Hour},
+		{name: "lowest jitter", failures: 1, jitter: 0, expected: 48 * time.Second},
+		{name: "highest jitter", failures: 1, jitter: 1, expected: 72 * time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, refreshBackoff(tt.failures, time.Hour, tt.jitter))
+		})
+	}
+}
+
+func TestRefreshScheduler_Refresh(t *testing.T) {
+	refresher := &fakeRefresher{err: errors.New("polar unreachable")}
+	scheduler := NewRefreshScheduler(refresher, time.Hour)
+
+	delay := scheduler.refresh(t.Context())
+	assert.Less(t, delay, 2*time.Minute)
+
+	status := scheduler.Status()
+	assert.Equal(t, 1, status.ConsecutiveFailures)
+	assert.Equal(t, "polar unreachable", status.LastError)
+	assert.NotNil(t, status.LastRefresh)
+
+	refresher.err = nil
+	delay = scheduler.refresh(t.Context())
+	assert.Equal(t, time.Hour, delay)
+
+	status = scheduler.Status()
+	assert.Equal(t, 0, status.ConsecutiveFailures)
+	assert.Empty(t, status.LastError)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), status.NextRefresh, time.Second)
+}